@@ -0,0 +1,160 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	logger "github.com/sirupsen/logrus"
+)
+
+// AdvancedCommerceAPIClient
+// A client for the server requests of the Advanced Commerce API. It shares the bearer token and request pipeline of AppStoreServerAPIClient.
+// Creating a subscription, modifying its items and one-time charges are in-app requests: build a models.SubscriptionCreateRequest,
+// models.SubscriptionModifyInAppRequest or models.OneTimeChargeCreateRequest, and let your app send it.
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi">Advanced Commerce API</a>
+type AdvancedCommerceAPIClient struct {
+	api *AppStoreServerAPIClient
+}
+
+func NewAdvancedCommerceAPIClientWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId string, environment types.Environment) (*AdvancedCommerceAPIClient, error) {
+	api, err := NewAPIClientWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId, environment)
+	if nil != err {
+		return nil, err
+	}
+	return &AdvancedCommerceAPIClient{api: api}, nil
+}
+
+func NewAdvancedCommerceAPIClient(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *AdvancedCommerceAPIClient {
+	return &AdvancedCommerceAPIClient{api: NewAPIClient(privateKey, keyId, issuer, bundleId)}
+}
+
+func (c *AdvancedCommerceAPIClient) SetEnv(environment types.Environment) {
+	c.api.SetEnv(environment)
+}
+
+var errAdvancedCommerceRequestRequired = errors.New("advanced commerce request is required")
+
+type validatable interface {
+	Validate() error
+}
+
+func (c *AdvancedCommerceAPIClient) changeSubscription(operation, transactionId string, request validatable) (*models.AdvancedCommerceSubscriptionResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := c.api.makeRequest(fmt.Sprintf("/advancedCommerce/v1/subscription/%s/%s", operation, transactionId), "POST", internal.WithBody(request))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.AdvancedCommerceSubscriptionResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse AdvancedCommerce %s response body failed [%v]", operation, err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ChangeSubscriptionMetadata
+// Change the display name, description or SKU of an Advanced Commerce subscription and its items.
+// @param transactionId The transaction identifier of the subscription.
+// @param request The request body with the new metadata.
+// @return The signed transaction and renewal information after the change.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/change-subscription-metadata">Change Subscription Metadata</a>
+func (c *AdvancedCommerceAPIClient) ChangeSubscriptionMetadata(transactionId string, request *models.SubscriptionChangeMetadataRequest) (*models.AdvancedCommerceSubscriptionResponse, error) {
+	if nil == request {
+		return nil, errAdvancedCommerceRequestRequired
+	}
+	return c.changeSubscription("changeMetadata", transactionId, request)
+}
+
+// ChangeSubscriptionPrice
+// Change the price of items in an Advanced Commerce subscription.
+// @param transactionId The transaction identifier of the subscription.
+// @param request The request body with the new prices.
+// @return The signed transaction and renewal information after the change.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/change-subscription-price">Change Subscription Price</a>
+func (c *AdvancedCommerceAPIClient) ChangeSubscriptionPrice(transactionId string, request *models.SubscriptionPriceChangeRequest) (*models.AdvancedCommerceSubscriptionResponse, error) {
+	if nil == request {
+		return nil, errAdvancedCommerceRequestRequired
+	}
+	return c.changeSubscription("changePrice", transactionId, request)
+}
+
+// CancelSubscription
+// Turn off automatic renewal of an Advanced Commerce subscription at the end of its current period.
+// @param transactionId The transaction identifier of the subscription.
+// @param request The request body with the request metadata.
+// @return The signed transaction and renewal information after the change.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/cancel-a-subscription">Cancel a Subscription</a>
+func (c *AdvancedCommerceAPIClient) CancelSubscription(transactionId string, request *models.SubscriptionCancelRequest) (*models.AdvancedCommerceSubscriptionResponse, error) {
+	if nil == request {
+		return nil, errAdvancedCommerceRequestRequired
+	}
+	return c.changeSubscription("cancel", transactionId, request)
+}
+
+// RevokeSubscription
+// Immediately end an Advanced Commerce subscription and refund it.
+// @param transactionId The transaction identifier of the subscription.
+// @param request The request body with the refund details.
+// @return The signed transaction and renewal information after the change.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/revoke-subscription">Revoke Subscription</a>
+func (c *AdvancedCommerceAPIClient) RevokeSubscription(transactionId string, request *models.SubscriptionRevokeRequest) (*models.AdvancedCommerceSubscriptionResponse, error) {
+	if nil == request {
+		return nil, errAdvancedCommerceRequestRequired
+	}
+	return c.changeSubscription("revoke", transactionId, request)
+}
+
+// MigrateSubscription
+// Migrate an auto-renewable subscription to an Advanced Commerce subscription.
+// @param transactionId The transaction identifier of the subscription to migrate.
+// @param request The request body with the items of the Advanced Commerce subscription.
+// @return The signed transaction and renewal information after the migration.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/migrate-a-subscription-to-advanced-commerce-api">Migrate a Subscription</a>
+func (c *AdvancedCommerceAPIClient) MigrateSubscription(transactionId string, request *models.SubscriptionMigrateRequest) (*models.AdvancedCommerceSubscriptionResponse, error) {
+	if nil == request {
+		return nil, errAdvancedCommerceRequestRequired
+	}
+	return c.changeSubscription("migrate", transactionId, request)
+}
+
+// RequestTransactionRefund
+// Request a FULL, PRORATED or CUSTOM refund of items in an Advanced Commerce transaction, such as a one-time charge.
+// Decode SignedTransactionInfo into models.JWSTransactionDecodedPayload with verifier.SignedDataVerifier to read
+// the refunds of each item in AdvancedCommerceInfo.Items[].Refunds.
+// @param transactionId The transaction identifier of the transaction to refund.
+// @param request The request body with the items to refund.
+// @return A response that contains the signed transaction after the refund request.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/request-a-refund">Request Transaction Refund</a>
+func (c *AdvancedCommerceAPIClient) RequestTransactionRefund(transactionId string, request *models.RequestRefundRequest) (*models.RequestRefundResponse, error) {
+	if nil == request {
+		return nil, errors.New("refund request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := c.api.makeRequest(fmt.Sprintf("/advancedCommerce/v1/transaction/requestRefund/%s", transactionId), "POST", internal.WithBody(request))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.RequestRefundResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse AdvancedCommerce requestRefund response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
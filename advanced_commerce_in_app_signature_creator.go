@@ -0,0 +1,72 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+)
+
+const advancedCommerceAudience = "advanced-commerce-api"
+
+// AdvancedCommerceInAppSignatureCreator
+// Signs the Advanced Commerce in-app requests your app sends to the App Store, with your In-App Purchase key from App Store Connect.
+// @see <a href="https://developer.apple.com/documentation/advancedcommerceapi/generating-jws-to-sign-app-store-requests">Generating JWS to sign App Store requests</a>
+type AdvancedCommerceInAppSignatureCreator struct {
+	creator *internal.JWSSignatureCreator
+}
+
+func NewAdvancedCommerceInAppSignatureCreator(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *AdvancedCommerceInAppSignatureCreator {
+	return &AdvancedCommerceInAppSignatureCreator{creator: &internal.JWSSignatureCreator{
+		Audience: advancedCommerceAudience, KeyId: keyId, PrivateKey: privateKey, Issuer: issuer, BundleId: bundleId,
+	}}
+}
+
+// CreateSignature
+// Validates and encodes the in-app request, and returns the JWS your app passes to StoreKit.
+// @param request The in-app request, such as a models.SubscriptionCreateRequest.
+// @return The signed JWS, with the base64 encoded request in its request claim.
+func (c *AdvancedCommerceInAppSignatureCreator) CreateSignature(request models.AdvancedCommerceInAppRequest) (string, error) {
+	if nil == request {
+		return "", errors.New("advanced commerce in-app request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	return c.creator.Create(map[string]interface{}{
+		"request": base64.StdEncoding.EncodeToString(encoded),
+	})
+}
+
+// VerifyAdvancedCommerceInAppSignature
+// Verifies a JWS created by AdvancedCommerceInAppSignatureCreator and decodes its request claim into request.
+// @param signature The JWS to verify.
+// @param publicKey The public key of the private key that signed the JWS.
+// @param bundleId The bundle ID the JWS must be created for.
+// @param request The in-app request to decode into, such as a *models.SubscriptionCreateRequest.
+func VerifyAdvancedCommerceInAppSignature(signature string, publicKey *ecdsa.PublicKey, bundleId string, request models.AdvancedCommerceInAppRequest) error {
+	claims, err := internal.VerifyJWSSignature(signature, publicKey, advancedCommerceAudience)
+	if err != nil {
+		return err
+	}
+	if bid, _ := claims["bid"].(string); bid != bundleId {
+		return fmt.Errorf("bundle id mismatch: got %q want %q", bid, bundleId)
+	}
+	encoded, ok := claims["request"].(string)
+	if !ok {
+		return errors.New("request claim is missing")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decoded, request)
+}
@@ -0,0 +1,101 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+)
+
+func newTestSigningKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestOneTimeChargeRequest(t *testing.T) *models.OneTimeChargeCreateRequest {
+	t.Helper()
+	requestInfo, err := models.NewAdvancedCommerceRequestInfo("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := models.NewOneTimeChargeCreateRequest(requestInfo, &models.AdvancedCommerceOneTimeChargeItem{
+		SKU: "com.example.sku", Description: "description", DisplayName: "display name", Price: 4990,
+	})
+	request.Currency = "USD"
+	request.TaxCode = "C003-00-1"
+	return request
+}
+
+func TestAdvancedCommerceInAppSignatureRoundTrip(t *testing.T) {
+	key := newTestSigningKey(t)
+	request := newTestOneTimeChargeRequest(t)
+	signature, err := NewAdvancedCommerceInAppSignatureCreator(key, "keyId", "issuerId", "com.example").CreateSignature(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := &models.OneTimeChargeCreateRequest{}
+	if err = VerifyAdvancedCommerceInAppSignature(signature, &key.PublicKey, "com.example", decoded); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if decoded.Operation != request.Operation || decoded.Version != request.Version ||
+		decoded.RequestInfo.RequestReferenceId != request.RequestInfo.RequestReferenceId ||
+		decoded.Currency != request.Currency || decoded.TaxCode != request.TaxCode ||
+		nil == decoded.Item || *decoded.Item != *request.Item {
+		t.Errorf("decoded request %+v does not match %+v", decoded, request)
+	}
+}
+
+func TestAdvancedCommerceInAppSignatureRejects(t *testing.T) {
+	key := newTestSigningKey(t)
+	signature, err := NewAdvancedCommerceInAppSignatureCreator(key, "keyId", "issuerId", "com.example").CreateSignature(newTestOneTimeChargeRequest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAudience, err := (&internal.JWSSignatureCreator{
+		Audience: "promotional-offer", KeyId: "keyId", PrivateKey: key, Issuer: "issuerId", BundleId: "com.example",
+	}).Create(map[string]interface{}{"request": "e30="})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		signature string
+		publicKey *ecdsa.PublicKey
+		bundleId  string
+	}{
+		{"wrong key", signature, &newTestSigningKey(t).PublicKey, "com.example"},
+		{"wrong bundleId", signature, &key.PublicKey, "com.example.other"},
+		{"wrong audience", otherAudience, &key.PublicKey, "com.example"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifyAdvancedCommerceInAppSignature(tt.signature, tt.publicKey, tt.bundleId, &models.OneTimeChargeCreateRequest{}); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestAdvancedCommerceInAppSignatureValidatesRequest(t *testing.T) {
+	request := newTestOneTimeChargeRequest(t)
+	request.Currency = ""
+	creator := NewAdvancedCommerceInAppSignatureCreator(newTestSigningKey(t), "keyId", "issuerId", "com.example")
+	if _, err := creator.CreateSignature(request); err == nil {
+		t.Error("expected an error for an invalid request")
+	}
+	if _, err := creator.CreateSignature((*models.OneTimeChargeCreateRequest)(nil)); err == nil {
+		t.Error("expected an error for a nil request")
+	}
+	if _, err := creator.CreateSignature(nil); err == nil {
+		t.Error("expected an error for a nil interface")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -82,7 +83,9 @@ func (c *AppStoreServerAPIClient) makeRequest(path, method string, options ...in
 		Timeout: time.Second * 10,
 	}
 	var bodyReader io.Reader = nil
-	if nil != reqData.Body {
+	if nil != reqData.RawBody {
+		bodyReader = bytes.NewReader(reqData.RawBody)
+	} else if nil != reqData.Body {
 		body, err := json.Marshal(reqData.Body)
 		if err != nil {
 			logger.Errorf("json marshal error: %v", err)
@@ -111,7 +114,9 @@ func (c *AppStoreServerAPIClient) makeRequest(path, method string, options ...in
 		}
 		req.URL.RawQuery = query.Encode()
 	}
-	if nil != reqData.Body {
+	if nil != reqData.RawBody {
+		req.Header.Add("Content-Type", reqData.ContentType)
+	} else if nil != reqData.Body {
 		req.Header.Add("Content-Type", "application/json")
 	}
 	// 发起请求
@@ -128,13 +133,13 @@ func (c *AppStoreServerAPIClient) makeRequest(path, method string, options ...in
 		logger.Fatalf("Error reading response body: %v", err)
 		return nil, err
 	}
-	if http.StatusOK != resp.StatusCode {
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
 		errPayload := &ErrorPayload{}
 		if err = json.Unmarshal(body, errPayload); err != nil {
 			logger.Errorf("parse err response body failed [%v]", err.Error())
 			return nil, APIError{HttpStatusCode: resp.StatusCode}
 		}
-		return nil, APIError{HttpStatusCode: resp.StatusCode}
+		return nil, APIError{HttpStatusCode: resp.StatusCode, ErrorCode: errPayload.ErrorCode, ErrorMessage: errPayload.ErrorMessage}
 	}
 	return body, nil
 }
@@ -191,3 +196,363 @@ func (c *AppStoreServerAPIClient) GetAllSubscriptionStatuses(transactionId strin
 
 	return response, nil
 }
+
+// GetTransactionHistory
+// Get a customer’s in-app purchase transaction history for your app.
+// @param transactionId The identifier of a transaction that belongs to the customer, and which may be an original transaction identifier.
+// @param revision A token you provide to get the next set of up to 20 transactions. All responses include a revision token. Use the revision token from the previous HistoryResponse.
+// @param request The query parameters that filter and sort the transaction history, may be nil.
+// @return A response that contains the customer’s transaction history for an app.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get_transaction_history">Get Transaction History</a>
+func (c *AppStoreServerAPIClient) GetTransactionHistory(transactionId, revision string, request *models.TransactionHistoryRequest) (*models.HistoryResponse, error) {
+	query := make(map[string][]string)
+	if "" != revision {
+		query["revision"] = []string{revision}
+	}
+	if nil != request {
+		if 0 < request.StartDate {
+			query["startDate"] = []string{strconv.FormatInt(request.StartDate, 10)}
+		}
+		if 0 < request.EndDate {
+			query["endDate"] = []string{strconv.FormatInt(request.EndDate, 10)}
+		}
+		if 0 < len(request.ProductIds) {
+			query["productId"] = request.ProductIds
+		}
+		if 0 < len(request.ProductTypes) {
+			query["productType"] = request.ProductTypes
+		}
+		if "" != request.Sort {
+			query["sort"] = []string{request.Sort}
+		}
+		if 0 < len(request.SubscriptionGroupIdentifiers) {
+			query["subscriptionGroupIdentifier"] = request.SubscriptionGroupIdentifiers
+		}
+		if "" != request.InAppOwnershipType {
+			query["inAppOwnershipType"] = []string{request.InAppOwnershipType}
+		}
+		if nil != request.Revoked {
+			query["revoked"] = []string{strconv.FormatBool(*request.Revoked)}
+		}
+	}
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v2/history/%s", transactionId), "GET", internal.WithQuery(query))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.HistoryResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse TransactionHistory response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetAllTransactionHistory
+// Get a customer’s complete in-app purchase transaction history for your app by following the revision token until hasMore is false.
+// @param transactionId The identifier of a transaction that belongs to the customer, and which may be an original transaction identifier.
+// @param request The query parameters that filter and sort the transaction history, may be nil.
+// @return The signed transactions of every page, in the order the App Store returned them.
+// @throws APIException If a response was returned indicating the request could not be processed
+func (c *AppStoreServerAPIClient) GetAllTransactionHistory(transactionId string, request *models.TransactionHistoryRequest) ([]string, error) {
+	var signedTransactions []string
+	revision := ""
+	for {
+		response, err := c.GetTransactionHistory(transactionId, revision, request)
+		if nil != err {
+			return nil, err
+		}
+		signedTransactions = append(signedTransactions, response.SignedTransactions...)
+		if !response.HasMore || "" == response.Revision {
+			return signedTransactions, nil
+		}
+		revision = response.Revision
+	}
+}
+
+// GetRefundHistory
+// Get a paginated list of all of a customer’s refunded in-app purchases for your app.
+// @param transactionId The identifier of a transaction that belongs to the customer, and which may be an original transaction identifier.
+// @param revision A token you provide to get the next set of up to 20 transactions. All responses include a revision token. Use the revision token from the previous RefundHistoryResponse.
+// @return A response that contains an array of signed JSON Web Signature (JWS) refunded transactions, and paging information.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get_refund_history">Get Refund History</a>
+func (c *AppStoreServerAPIClient) GetRefundHistory(transactionId, revision string) (*models.RefundHistoryResponse, error) {
+	query := make(map[string][]string)
+	if "" != revision {
+		query["revision"] = []string{revision}
+	}
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v2/refund/lookup/%s", transactionId), "GET", internal.WithQuery(query))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.RefundHistoryResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse RefundHistory response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetAllRefundHistory
+// Get every refunded in-app purchase of a customer for your app by following the revision token until hasMore is false.
+// @param transactionId The identifier of a transaction that belongs to the customer, and which may be an original transaction identifier.
+// @return The signed refunded transactions of every page, sorted in ascending order by revocationDate.
+// @throws APIException If a response was returned indicating the request could not be processed
+func (c *AppStoreServerAPIClient) GetAllRefundHistory(transactionId string) ([]string, error) {
+	var signedTransactions []string
+	revision := ""
+	for {
+		response, err := c.GetRefundHistory(transactionId, revision)
+		if nil != err {
+			return nil, err
+		}
+		signedTransactions = append(signedTransactions, response.SignedTransactions...)
+		if !response.HasMore || "" == response.Revision {
+			return signedTransactions, nil
+		}
+		revision = response.Revision
+	}
+}
+
+// LookUpOrderId
+// Get a customer’s in-app purchases from a receipt using the order ID.
+// @param orderId The order ID for in-app purchases that belong to the customer.
+// @return A response that includes the order lookup status and an array of signed transactions for the in-app purchases in the order.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/look_up_order_id">Look Up Order ID</a>
+func (c *AppStoreServerAPIClient) LookUpOrderId(orderId string) (*models.OrderLookupResponse, error) {
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v1/lookup/%s", orderId), "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.OrderLookupResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse OrderLookup response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SendConsumptionData
+// Send consumption information about a consumable in-app purchase to the App Store after your server receives a consumption request notification.
+// @param transactionId The transaction identifier for which you’re providing consumption information. You receive this identifier in the CONSUMPTION_REQUEST notification the App Store sends to your server.
+// @param request The request body containing consumption information.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/send_consumption_information">Send Consumption Information</a>
+func (c *AppStoreServerAPIClient) SendConsumptionData(transactionId string, request *models.ConsumptionRequest) error {
+	if nil == request {
+		return errors.New("consumption request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	_, err := c.makeRequest(fmt.Sprintf("/inApps/v1/transactions/consumption/%s", transactionId), "PUT", internal.WithBody(request))
+	return err
+}
+
+// ExtendSubscriptionRenewalDate
+// Extends the renewal date of a customer’s active subscription using the original transaction identifier.
+// The request is validated locally before it is sent, so out-of-range days or reason codes fail without calling the App Store.
+// @param originalTransactionId The original transaction identifier of the subscription receiving a renewal date extension.
+// @param request The request body containing subscription-renewal-extension data.
+// @return A response that indicates whether an individual renewal-date extension succeeded, and related details.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/extend_a_subscription_renewal_date">Extend a Subscription Renewal Date</a>
+func (c *AppStoreServerAPIClient) ExtendSubscriptionRenewalDate(originalTransactionId string, request *models.ExtendRenewalDateRequest) (*models.ExtendRenewalDateResponse, error) {
+	if nil == request {
+		return nil, errors.New("extend renewal date request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v1/subscriptions/extend/%s", originalTransactionId), "PUT", internal.WithBody(request))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.ExtendRenewalDateResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse ExtendRenewalDate response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ExtendRenewalDateForAllActiveSubscribers
+// Uses a subscription’s product identifier to extend the renewal date for all of its eligible active subscribers.
+// @param request The request body for extending a subscription renewal date for all of its active subscribers.
+// @return A response that indicates the server successfully received the subscription-renewal-date extension request.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/extend_subscription_renewal_dates_for_all_active_subscribers">Extend Subscription Renewal Dates for All Active Subscribers</a>
+func (c *AppStoreServerAPIClient) ExtendRenewalDateForAllActiveSubscribers(request *models.MassExtendRenewalDateRequest) (*models.MassExtendRenewalDateResponse, error) {
+	if nil == request {
+		return nil, errors.New("mass extend renewal date request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := c.makeRequest("/inApps/v1/subscriptions/extend/mass", "POST", internal.WithBody(request))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.MassExtendRenewalDateResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse MassExtendRenewalDate response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetStatusOfSubscriptionRenewalDateExtensions
+// Checks whether a renewal date extension request completed, and provides the final count of successful or failed extensions.
+// @param productId The product identifier of the auto-renewable subscription that you request a renewal-date extension for.
+// @param requestIdentifier The UUID that represents your request to the Extend Subscription Renewal Dates for All Active Subscribers endpoint.
+// @return A response that indicates the current status of a request to extend the subscription renewal date to all eligible subscribers.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get_status_of_subscription_renewal_date_extensions">Get Status of Subscription Renewal Date Extensions</a>
+func (c *AppStoreServerAPIClient) GetStatusOfSubscriptionRenewalDateExtensions(productId, requestIdentifier string) (*models.MassExtendRenewalDateStatusResponse, error) {
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v1/subscriptions/extend/mass/%s/%s", productId, requestIdentifier), "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.MassExtendRenewalDateStatusResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse MassExtendRenewalDateStatus response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetNotificationHistory
+// Get a list of notifications that the App Store server attempted to send to your server.
+// @param paginationToken An optional token you use to get the next set of up to 20 notification history records. All responses that have more records available include a paginationToken. Omit this parameter the first time you call this endpoint.
+// @param request The request body that includes the start and end dates, and optional query constraints.
+// @return A response that contains the App Store Server Notifications history for your app.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get_notification_history">Get Notification History</a>
+func (c *AppStoreServerAPIClient) GetNotificationHistory(paginationToken string, request *models.NotificationHistoryRequest) (*models.NotificationHistoryResponse, error) {
+	if nil == request {
+		return nil, errors.New("notification history request is required")
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	query := make(map[string][]string)
+	if "" != paginationToken {
+		query["paginationToken"] = []string{paginationToken}
+	}
+	body, err := c.makeRequest("/inApps/v1/notifications/history", "POST", internal.WithQuery(query), internal.WithBody(request))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.NotificationHistoryResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse NotificationHistory response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetAllNotificationHistory
+// Get every notification history record matching the request by following the pagination token until hasMore is false.
+// @param request The request body that includes the start and end dates, and optional query constraints.
+// @return The notification history records of every page.
+// @throws APIException If a response was returned indicating the request could not be processed
+func (c *AppStoreServerAPIClient) GetAllNotificationHistory(request *models.NotificationHistoryRequest) ([]*models.NotificationHistoryResponseItem, error) {
+	var items []*models.NotificationHistoryResponseItem
+	paginationToken := ""
+	for {
+		response, err := c.GetNotificationHistory(paginationToken, request)
+		if nil != err {
+			return nil, err
+		}
+		items = append(items, response.NotificationHistory...)
+		if !response.HasMore || "" == response.PaginationToken {
+			return items, nil
+		}
+		paginationToken = response.PaginationToken
+	}
+}
+
+// RequestTestNotification
+// Ask App Store Server Notifications to send a test notification to your server.
+// @return A response that contains the test notification token.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/request_a_test_notification">Request a Test Notification</a>
+func (c *AppStoreServerAPIClient) RequestTestNotification() (*models.SendTestNotificationResponse, error) {
+	body, err := c.makeRequest("/inApps/v1/notifications/test", "POST")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.SendTestNotificationResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse SendTestNotification response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetTestNotificationStatus
+// Check the status of the test App Store server notification sent to your server.
+// @param testNotificationToken The test notification token received from the Request a Test Notification endpoint
+// @return A response that contains the contents of the test notification sent by the App Store server and the result from your server.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get_test_notification_status">Get Test Notification Status</a>
+func (c *AppStoreServerAPIClient) GetTestNotificationStatus(testNotificationToken string) (*models.CheckTestNotificationResponse, error) {
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v1/notifications/test/%s", testNotificationToken), "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.CheckTestNotificationResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse CheckTestNotification response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetAppTransactionInfo
+// Get a customer’s app transaction information for your app.
+// Decode SignedAppTransactionInfo with verifier.SignedDataVerifier.VerifyAndDecodeAppTransaction.
+// @param transactionId Any originalTransactionId, transactionId or appTransactionId that belongs to the customer for your app.
+// @return A response that contains signed app transaction information for a customer.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get-app-transaction-info">Get App Transaction Info</a>
+func (c *AppStoreServerAPIClient) GetAppTransactionInfo(transactionId string) (*models.AppTransactionInfoResponse, error) {
+	body, err := c.makeRequest(fmt.Sprintf("/inApps/v1/transactions/appTransactions/%s", transactionId), "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.AppTransactionInfoResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse AppTransactionInfo response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SetAppAccountToken
+// Sets the app account token value for a purchase the customer makes outside your app, or updates its value in an existing transaction.
+// @param originalTransactionId The original transaction identifier of the transaction to receive the app account token update.
+// @param appAccountToken The UUID that associates the transaction with a customer on your own service.
+// @throws APIException If a response was returned indicating the request could not be processed, for example
+// ErrorCodeFamilyTransactionNotSupported for family-shared transactions or ErrorCodeTransactionIdNotOriginalTransactionId.
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/set-app-account-token">Set App Account Token</a>
+func (c *AppStoreServerAPIClient) SetAppAccountToken(originalTransactionId, appAccountToken string) error {
+	if !internal.IsUUID(appAccountToken) {
+		return fmt.Errorf("%w: %q", ErrInvalidAppAccountToken, appAccountToken)
+	}
+	request := &models.UpdateAppAccountTokenRequest{AppAccountToken: appAccountToken}
+	_, err := c.makeRequest(fmt.Sprintf("/inApps/v1/transactions/%s/appAccountToken", originalTransactionId), "PUT", internal.WithBody(request))
+	return err
+}
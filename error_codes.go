@@ -0,0 +1,101 @@
+package apple_store_server
+
+import "errors"
+
+// ErrInvalidAppAccountToken is returned before calling the App Store when an app account token isn't a UUID.
+var ErrInvalidAppAccountToken = errors.New("appAccountToken must be a UUID")
+
+// Error codes the App Store Server API returns in APIError.ErrorCode.
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/error_codes">Error codes</a>
+const (
+	// ErrorCodeGeneralBadRequest An error that indicates an invalid request.
+	ErrorCodeGeneralBadRequest int64 = 4000000
+	// ErrorCodeInvalidAppIdentifier An error that indicates an invalid app identifier.
+	ErrorCodeInvalidAppIdentifier int64 = 4000002
+	// ErrorCodeInvalidRequestRevision An error that indicates an invalid request revision.
+	ErrorCodeInvalidRequestRevision int64 = 4000005
+	// ErrorCodeInvalidTransactionId An error that indicates an invalid transaction identifier.
+	ErrorCodeInvalidTransactionId int64 = 4000006
+	// ErrorCodeInvalidOriginalTransactionId An error that indicates an invalid original transaction identifier.
+	ErrorCodeInvalidOriginalTransactionId int64 = 4000008
+	// ErrorCodeInvalidExtendByDays An error that indicates an invalid extend-by-days value.
+	ErrorCodeInvalidExtendByDays int64 = 4000009
+	// ErrorCodeInvalidExtendReasonCode An error that indicates an invalid reason code.
+	ErrorCodeInvalidExtendReasonCode int64 = 4000010
+	// ErrorCodeInvalidRequestIdentifier An error that indicates an invalid request identifier.
+	ErrorCodeInvalidRequestIdentifier int64 = 4000011
+	// ErrorCodeStartDateTooFarInPast An error that indicates that the start date is earlier than the earliest allowed date.
+	ErrorCodeStartDateTooFarInPast int64 = 4000012
+	// ErrorCodeStartDateAfterEndDate An error that indicates that the end date precedes the start date, or the two dates are equal.
+	ErrorCodeStartDateAfterEndDate int64 = 4000013
+	// ErrorCodeInvalidPaginationToken An error that indicates the pagination token is invalid.
+	ErrorCodeInvalidPaginationToken int64 = 4000014
+	// ErrorCodeInvalidStartDate An error that indicates the start date is invalid.
+	ErrorCodeInvalidStartDate int64 = 4000015
+	// ErrorCodeInvalidEndDate An error that indicates the end date is invalid.
+	ErrorCodeInvalidEndDate int64 = 4000016
+	// ErrorCodePaginationTokenExpired An error that indicates the pagination token expired.
+	ErrorCodePaginationTokenExpired int64 = 4000017
+	// ErrorCodeInvalidNotificationType An error that indicates the notification type or subtype is invalid.
+	ErrorCodeInvalidNotificationType int64 = 4000018
+	// ErrorCodeMultipleFiltersSupplied An error that indicates the request is invalid because it has too many constraints applied.
+	ErrorCodeMultipleFiltersSupplied int64 = 4000019
+	// ErrorCodeInvalidTestNotificationToken An error that indicates the test notification token is invalid.
+	ErrorCodeInvalidTestNotificationToken int64 = 4000020
+	// ErrorCodeInvalidSort An error that indicates an invalid sort parameter.
+	ErrorCodeInvalidSort int64 = 4000021
+	// ErrorCodeInvalidProductType An error that indicates an invalid product type parameter.
+	ErrorCodeInvalidProductType int64 = 4000022
+	// ErrorCodeInvalidProductId An error that indicates the product ID parameter is invalid.
+	ErrorCodeInvalidProductId int64 = 4000023
+	// ErrorCodeInvalidSubscriptionGroupIdentifier An error that indicates an invalid subscription group identifier.
+	ErrorCodeInvalidSubscriptionGroupIdentifier int64 = 4000024
+	// ErrorCodeInvalidInAppOwnershipType An error that indicates an invalid in-app ownership type parameter.
+	ErrorCodeInvalidInAppOwnershipType int64 = 4000026
+	// ErrorCodeInvalidEmptyStorefrontCountryCodeList An error that indicates a required storefront country code is empty.
+	ErrorCodeInvalidEmptyStorefrontCountryCodeList int64 = 4000027
+	// ErrorCodeInvalidStorefrontCountryCode An error that indicates a storefront code is invalid.
+	ErrorCodeInvalidStorefrontCountryCode int64 = 4000028
+	// ErrorCodeInvalidRevoked An error that indicates the revoked parameter contains an invalid value.
+	ErrorCodeInvalidRevoked int64 = 4000030
+	// ErrorCodeInvalidStatus An error that indicates the status parameter is invalid.
+	ErrorCodeInvalidStatus int64 = 4000031
+	// ErrorCodeInvalidAppAccountTokenUUID An error that indicates the app account token value is not a valid UUID.
+	ErrorCodeInvalidAppAccountTokenUUID int64 = 4000183
+	// ErrorCodeFamilyTransactionNotSupported An error that indicates the transaction is for a product the customer obtains through Family Sharing, which the endpoint doesn’t support.
+	ErrorCodeFamilyTransactionNotSupported int64 = 4000185
+	// ErrorCodeTransactionIdNotOriginalTransactionId An error that indicates the endpoint expects an original transaction identifier.
+	ErrorCodeTransactionIdNotOriginalTransactionId int64 = 4000187
+	// ErrorCodeSubscriptionExtensionIneligible An error that indicates the subscription doesn't qualify for a renewal-date extension due to its subscription state.
+	ErrorCodeSubscriptionExtensionIneligible int64 = 4030004
+	// ErrorCodeSubscriptionMaxExtension An error that indicates the subscription doesn’t qualify for a renewal-date extension because it has already received the maximum extensions.
+	ErrorCodeSubscriptionMaxExtension int64 = 4030005
+	// ErrorCodeFamilySharedSubscriptionExtensionIneligible An error that indicates a subscription isn't directly eligible for a renewal date extension because the user obtained it through Family Sharing.
+	ErrorCodeFamilySharedSubscriptionExtensionIneligible int64 = 4030007
+	// ErrorCodeAccountNotFound An error that indicates the App Store account wasn’t found.
+	ErrorCodeAccountNotFound int64 = 4040001
+	// ErrorCodeAccountNotFoundRetryable An error response that indicates the App Store account wasn’t found, but you can try again.
+	ErrorCodeAccountNotFoundRetryable int64 = 4040002
+	// ErrorCodeAppNotFound An error that indicates the app wasn’t found.
+	ErrorCodeAppNotFound int64 = 4040003
+	// ErrorCodeAppNotFoundRetryable An error response that indicates the app wasn’t found, but you can try again.
+	ErrorCodeAppNotFoundRetryable int64 = 4040004
+	// ErrorCodeOriginalTransactionIdNotFound An error that indicates an original transaction identifier wasn't found.
+	ErrorCodeOriginalTransactionIdNotFound int64 = 4040005
+	// ErrorCodeOriginalTransactionIdNotFoundRetryable An error response that indicates the original transaction identifier wasn’t found, but you can try again.
+	ErrorCodeOriginalTransactionIdNotFoundRetryable int64 = 4040006
+	// ErrorCodeServerNotificationUrlNotFound An error that indicates that the App Store server couldn’t find a notifications URL for your app in this environment.
+	ErrorCodeServerNotificationUrlNotFound int64 = 4040007
+	// ErrorCodeTestNotificationNotFound An error that indicates that the test notification token is expired or the test notification status isn’t available.
+	ErrorCodeTestNotificationNotFound int64 = 4040008
+	// ErrorCodeStatusRequestNotFound An error that indicates the server didn't find a subscription-renewal-date extension request for the request identifier and product identifier you provided.
+	ErrorCodeStatusRequestNotFound int64 = 4040009
+	// ErrorCodeTransactionIdNotFound An error that indicates a transaction identifier wasn't found.
+	ErrorCodeTransactionIdNotFound int64 = 4040010
+	// ErrorCodeRateLimitExceeded An error that indicates that the request exceeded the rate limit.
+	ErrorCodeRateLimitExceeded int64 = 4290000
+	// ErrorCodeGeneralInternal An error that indicates a general internal error.
+	ErrorCodeGeneralInternal int64 = 5000000
+	// ErrorCodeGeneralInternalRetryable An error response that indicates an unknown error occurred, but you can try again.
+	ErrorCodeGeneralInternalRetryable int64 = 5000001
+)
@@ -0,0 +1,87 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	logger "github.com/sirupsen/logrus"
+)
+
+// ExternalPurchaseAPIClient
+// A client for the External Purchase Server API, which receives the reports of purchases your app makes
+// with the External Purchase Link Entitlement. It shares the bearer token and request pipeline of AppStoreServerAPIClient.
+// Error responses are returned as APIError, with an ErrorCode from types.ExternalPurchaseErrorCode.
+// @see <a href="https://developer.apple.com/documentation/externalpurchaseserverapi">External Purchase Server API</a>
+type ExternalPurchaseAPIClient struct {
+	api *AppStoreServerAPIClient
+}
+
+func NewExternalPurchaseAPIClientWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId string, environment types.Environment) (*ExternalPurchaseAPIClient, error) {
+	api, err := NewAPIClientWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId, environment)
+	if nil != err {
+		return nil, err
+	}
+	return &ExternalPurchaseAPIClient{api: api}, nil
+}
+
+func NewExternalPurchaseAPIClient(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *ExternalPurchaseAPIClient {
+	return &ExternalPurchaseAPIClient{api: NewAPIClient(privateKey, keyId, issuer, bundleId)}
+}
+
+func (c *ExternalPurchaseAPIClient) SetEnv(environment types.Environment) {
+	c.api.SetEnv(environment)
+}
+
+// SendExternalPurchaseReport
+// Send the report of the purchases and refunds made with an external purchase token.
+// @param report The line items of the token, or NoLineItems when the customer made no purchase.
+// @return A response that indicates Apple received the report.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/externalpurchaseserverapi/send-external-purchase-report">Send External Purchase Report</a>
+func (c *ExternalPurchaseAPIClient) SendExternalPurchaseReport(report *models.ExternalPurchaseReport) (*models.ExternalPurchaseReportResponse, error) {
+	if nil == report {
+		return nil, errors.New("external purchase report is required")
+	}
+	if err := report.Validate(); err != nil {
+		return nil, err
+	}
+	body, err := c.api.makeRequest("/externalPurchase/v1/reports", "PUT", internal.WithBody(report))
+	if nil != err {
+		return nil, err
+	}
+	response := &models.ExternalPurchaseReportResponse{RequestIdentifier: report.RequestIdentifier}
+	if 0 == len(body) {
+		return response, nil
+	}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse ExternalPurchaseReport response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetExternalPurchaseReportStatus
+// Get the processing status of an external purchase report, including the errors of a rejected report.
+// @param requestIdentifier The requestIdentifier of the report.
+// @return A response that contains the processing status of the report.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/externalpurchaseserverapi/retrieve-external-purchase-report">Retrieve External Purchase Report</a>
+func (c *ExternalPurchaseAPIClient) GetExternalPurchaseReportStatus(requestIdentifier string) (*models.ExternalPurchaseReportStatusResponse, error) {
+	body, err := c.api.makeRequest(fmt.Sprintf("/externalPurchase/v1/reports/%s", requestIdentifier), "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.ExternalPurchaseReportStatusResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse ExternalPurchaseReportStatus response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ASN.1 universal tags used by PKCS#7 app receipts.
+const (
+	TagInteger     = 2
+	TagOctetString = 4
+	TagOID         = 6
+	TagUTF8String  = 12
+	TagSequence    = 16
+	TagSet         = 17
+	TagIA5String   = 22
+
+	ClassUniversal       = 0
+	ClassContextSpecific = 2
+)
+
+// maxBERDepth bounds the nesting of constructed elements. Receipts nest a few levels deep, and
+// rejecting deeper input keeps untrusted data from exhausting the stack.
+const maxBERDepth = 32
+
+var (
+	errBERTruncated = errors.New("ber: data truncated")
+	errBERTooDeep   = errors.New("ber: elements nested too deeply")
+)
+
+// BERValue is one decoded BER element. App receipts use the indefinite-length form in their
+// PKCS#7 wrapper, which encoding/asn1 doesn't accept, so receipts are read with this minimal decoder.
+type BERValue struct {
+	Class       int
+	Tag         int
+	Constructed bool
+	// Content holds the content octets; for the indefinite-length form it excludes the end-of-contents marker.
+	Content []byte
+	// Raw holds the complete encoding, including the identifier and length octets.
+	Raw []byte
+
+	depth int
+	// children holds the elements of the indefinite-length form, which are decoded to find its end.
+	children   []*BERValue
+	indefinite bool
+}
+
+// ParseBER decodes the first element of data and returns it with the remaining bytes.
+func ParseBER(data []byte) (*BERValue, []byte, error) {
+	return parseBER(data, 0)
+}
+
+func parseBER(data []byte, depth int) (*BERValue, []byte, error) {
+	if depth > maxBERDepth {
+		return nil, nil, errBERTooDeep
+	}
+	if len(data) < 2 {
+		return nil, nil, errBERTruncated
+	}
+	v := &BERValue{Class: int(data[0] >> 6), Constructed: data[0]&0x20 != 0, Tag: int(data[0] & 0x1f), depth: depth}
+	offset := 1
+	if v.Tag == 0x1f {
+		v.Tag = 0
+		for {
+			if offset >= len(data) {
+				return nil, nil, errBERTruncated
+			}
+			b := data[offset]
+			offset++
+			v.Tag = v.Tag<<7 | int(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+			if v.Tag > 1<<24 {
+				return nil, nil, errors.New("ber: tag too large")
+			}
+		}
+	}
+	if offset >= len(data) {
+		return nil, nil, errBERTruncated
+	}
+	lengthByte := data[offset]
+	offset++
+	if lengthByte == 0x80 {
+		if !v.Constructed {
+			return nil, nil, errors.New("ber: indefinite length on primitive element")
+		}
+		v.indefinite = true
+		rest := data[offset:]
+		for {
+			if len(rest) >= 2 && rest[0] == 0 && rest[1] == 0 {
+				end := len(data) - len(rest)
+				v.Content = data[offset:end]
+				v.Raw = data[:end+2]
+				return v, rest[2:], nil
+			}
+			child, r, err := parseBER(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			v.children = append(v.children, child)
+			rest = r
+		}
+	}
+	length := int(lengthByte)
+	if lengthByte&0x80 != 0 {
+		n := int(lengthByte & 0x7f)
+		if n > 4 || offset+n > len(data) {
+			return nil, nil, fmt.Errorf("ber: invalid length of %d bytes", n)
+		}
+		length = 0
+		for _, b := range data[offset : offset+n] {
+			length = length<<8 | int(b)
+		}
+		offset += n
+	}
+	if length < 0 || offset+length > len(data) {
+		return nil, nil, errBERTruncated
+	}
+	v.Content = data[offset : offset+length]
+	v.Raw = data[:offset+length]
+	return v, data[offset+length:], nil
+}
+
+// Children decodes the elements of a constructed value.
+func (v *BERValue) Children() ([]*BERValue, error) {
+	if !v.Constructed {
+		return nil, errors.New("ber: primitive element has no children")
+	}
+	if v.indefinite {
+		return v.children, nil
+	}
+	var children []*BERValue
+	rest := v.Content
+	for len(rest) > 0 {
+		child, r, err := parseBER(rest, v.depth+1)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+		rest = r
+	}
+	return children, nil
+}
+
+// Is reports whether v is a universal element with the given tag.
+func (v *BERValue) Is(tag int) bool {
+	return v.Class == ClassUniversal && v.Tag == tag
+}
+
+// Bytes returns the content of a string type, joining the segments of the constructed form.
+func (v *BERValue) Bytes() ([]byte, error) {
+	if !v.Constructed {
+		return v.Content, nil
+	}
+	children, err := v.Children()
+	if err != nil {
+		return nil, err
+	}
+	var out []byte
+	for _, child := range children {
+		b, err := child.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b...)
+	}
+	return out, nil
+}
+
+// Int returns the value of an INTEGER that fits in an int64.
+func (v *BERValue) Int() (int64, error) {
+	if !v.Is(TagInteger) || v.Constructed || len(v.Content) == 0 || len(v.Content) > 8 {
+		return 0, errors.New("ber: not a small integer")
+	}
+	n := int64(int8(v.Content[0]))
+	for _, b := range v.Content[1:] {
+		n = n<<8 | int64(b)
+	}
+	return n, nil
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// nestedIndefinite returns depth SEQUENCEs in the indefinite-length form around an empty SEQUENCE.
+func nestedIndefinite(depth int) []byte {
+	data := append(bytes.Repeat([]byte{0x30, 0x80}, depth), 0x30, 0x00)
+	return append(data, bytes.Repeat([]byte{0x00, 0x00}, depth)...)
+}
+
+func TestParseBER(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"definite length", []byte{0x02, 0x01, 0x05}, nil},
+		{"indefinite length", []byte{0x30, 0x80, 0x02, 0x01, 0x05, 0x00, 0x00}, nil},
+		{"nesting at the limit", nestedIndefinite(maxBERDepth), nil},
+		{"nesting past the limit", nestedIndefinite(maxBERDepth + 1), errBERTooDeep},
+		{"unterminated deep nesting", bytes.Repeat([]byte{0x30, 0x80}, 100000), errBERTooDeep},
+		{"truncated content", []byte{0x04, 0x05, 0x01}, errBERTruncated},
+		{"truncated header", []byte{0x04}, errBERTruncated},
+		{"missing end-of-contents", []byte{0x30, 0x80, 0x02, 0x01, 0x05}, errBERTruncated},
+		{"indefinite-length primitive", []byte{0x04, 0x80, 0x01, 0x00, 0x00}, errors.New("ber: indefinite length on primitive element")},
+		{"length too long", []byte{0x04, 0x85, 0x01, 0x01, 0x01, 0x01, 0x01}, errors.New("ber: invalid length of 5 bytes")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseBER(tt.data)
+			if (nil == tt.err) != (nil == err) || (nil != err && tt.err.Error() != err.Error()) {
+				t.Errorf("got error %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestChildrenOfIndefiniteLength(t *testing.T) {
+	v, rest, err := ParseBER([]byte{0x30, 0x80, 0x02, 0x01, 0x05, 0x04, 0x01, 0x06, 0x00, 0x00, 0xff})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal([]byte{0xff}, rest) {
+		t.Errorf("unexpected rest % x", rest)
+	}
+	children, err := v.Children()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if 2 != len(children) || !children[0].Is(TagInteger) || !children[1].Is(TagOctetString) {
+		t.Fatalf("unexpected children %+v", children)
+	}
+	if n, err := children[0].Int(); err != nil || 5 != n {
+		t.Errorf("got %d, %v", n, err)
+	}
+}
+
+func TestChildrenDepth(t *testing.T) {
+	// Definite-length nesting is decoded one level per Children call, which must also respect the limit.
+	data := []byte{0x02, 0x01, 0x05}
+	for i := 0; i <= maxBERDepth; i++ {
+		data = append([]byte{0x30, 0x82, byte(len(data) >> 8), byte(len(data))}, data...)
+	}
+	v, _, err := ParseBER(data)
+	for nil == err && v.Constructed {
+		var children []*BERValue
+		if children, err = v.Children(); nil == err {
+			v = children[0]
+		}
+	}
+	if !errors.Is(err, errBERTooDeep) {
+		t.Errorf("got error %v, want %v", err, errBERTooDeep)
+	}
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWSSignatureCreator signs the JWS payloads your server provides to StoreKit and the App Store,
+// such as promotional offer signatures, with your In-App Purchase key from App Store Connect.
+type JWSSignatureCreator struct {
+	// The audience claim that identifies the feature the signature is used for.
+	Audience   string
+	KeyId      string
+	PrivateKey *ecdsa.PrivateKey
+	// Your issuer ID from the Keys page in App Store Connect (Ex: "57246542-96fe-1a63-e053-0824d011072a")
+	Issuer string
+	// Your app’s bundle ID (Ex: “com.example.testbundleid”)
+	BundleId string
+}
+
+// Create returns the compact JWS of the common claims (bid, iss, aud, iat, nonce) combined with featureClaims.
+func (c *JWSSignatureCreator) Create(featureClaims map[string]interface{}) (string, error) {
+	if c.PrivateKey == nil {
+		return "", errors.New("PrivateKey not given")
+	}
+	nonce, err := NewUUID()
+	if err != nil {
+		return "", err
+	}
+	claims := jwt.MapClaims{}
+	for k, v := range featureClaims {
+		claims[k] = v
+	}
+	claims["bid"] = c.BundleId
+	claims["iss"] = c.Issuer
+	claims["aud"] = c.Audience
+	claims["iat"] = time.Now().Unix()
+	claims["nonce"] = nonce
+	token := jwt.Token{
+		Header: map[string]interface{}{
+			"typ": "JWT",
+			"alg": jwt.SigningMethodES256.Alg(),
+			"kid": c.KeyId,
+		},
+		Claims: claims,
+		Method: jwt.SigningMethodES256,
+	}
+	return token.SignedString(c.PrivateKey)
+}
+
+// VerifyJWSSignature verifies a JWS created by JWSSignatureCreator with the public key of its signing key
+// and returns its claims. The alg header must be ES256 and the aud claim must equal audience.
+func VerifyJWSSignature(signature string, publicKey *ecdsa.PublicKey, audience string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(signature, claims, func(token *jwt.Token) (interface{}, error) {
+		return publicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}), jwt.WithAudience(audience), jwt.WithIssuedAt())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
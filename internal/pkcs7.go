@@ -0,0 +1,265 @@
+package internal
+
+import (
+	"encoding/asn1"
+	"errors"
+	"math/big"
+)
+
+var errNotSignedData = errors.New("receipt: not a PKCS#7 signed-data message")
+
+// SignedData holds the parts of a PKCS#7 signed-data message that app receipts use.
+type SignedData struct {
+	// Content is the encapsulated content, the receipt payload.
+	Content []byte
+	// Certificates holds the DER encoding of each certificate in the message.
+	Certificates [][]byte
+	SignerInfos  []SignerInfo
+}
+
+// SignerInfo holds the signature of one signer of a signed-data message.
+type SignerInfo struct {
+	// IssuerAndSerialNumber of the signer certificate.
+	Issuer          []byte
+	SerialNumber    *big.Int
+	DigestAlgorithm asn1.ObjectIdentifier
+	// SignedAttributes is the DER encoding, with the SET tag, that the signature covers.
+	// It is nil when the signature covers the content directly.
+	SignedAttributes []byte
+	// MessageDigest is the digest of the content from the signed attributes.
+	MessageDigest      []byte
+	SignatureAlgorithm asn1.ObjectIdentifier
+	Signature          []byte
+}
+
+var oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+
+// ParseSignedData decodes the ContentInfo wrapping an app receipt.
+func ParseSignedData(data []byte) (*SignedData, error) {
+	contentInfo, _, err := ParseBER(data)
+	if err != nil {
+		return nil, err
+	}
+	contentInfoFields, err := sequence(contentInfo, 2)
+	if err != nil || !contentInfoFields[1].isExplicit(0) {
+		return nil, errNotSignedData
+	}
+	explicit, err := contentInfoFields[1].Children()
+	if err != nil || 1 != len(explicit) {
+		return nil, errNotSignedData
+	}
+	signedDataFields, err := sequence(explicit[0], 4)
+	if err != nil {
+		return nil, errNotSignedData
+	}
+	// version, digestAlgorithms, encapContentInfo, ...
+	encapContentInfo, err := sequence(signedDataFields[2], 1)
+	if err != nil {
+		return nil, errNotSignedData
+	}
+	signedData := &SignedData{}
+	if len(encapContentInfo) > 1 {
+		if !encapContentInfo[1].isExplicit(0) {
+			return nil, errNotSignedData
+		}
+		eContent, err := encapContentInfo[1].Children()
+		if err != nil || 1 != len(eContent) || !eContent[0].Is(TagOctetString) {
+			return nil, errNotSignedData
+		}
+		if signedData.Content, err = eContent[0].Bytes(); err != nil {
+			return nil, err
+		}
+	}
+	for _, field := range signedDataFields[3:] {
+		switch {
+		case field.Class == ClassContextSpecific && 0 == field.Tag:
+			certificates, err := field.Children()
+			if err != nil {
+				return nil, err
+			}
+			for _, certificate := range certificates {
+				signedData.Certificates = append(signedData.Certificates, certificate.Raw)
+			}
+		case field.Is(TagSet):
+			signerInfos, err := field.Children()
+			if err != nil {
+				return nil, err
+			}
+			for _, signerInfo := range signerInfos {
+				info, err := parseSignerInfo(signerInfo)
+				if err != nil {
+					return nil, err
+				}
+				signedData.SignerInfos = append(signedData.SignerInfos, *info)
+			}
+		}
+	}
+	return signedData, nil
+}
+
+func parseSignerInfo(v *BERValue) (*SignerInfo, error) {
+	fields, err := sequence(v, 5)
+	if err != nil {
+		return nil, err
+	}
+	// version, sid, digestAlgorithm, [0] signedAttrs, signatureAlgorithm, signature, [1] unsignedAttrs
+	sid, err := sequence(fields[1], 2)
+	if err != nil {
+		return nil, errors.New("receipt: signer is not identified by issuer and serial number")
+	}
+	info := &SignerInfo{Issuer: sid[0].Raw, SerialNumber: new(big.Int)}
+	if _, err = asn1.Unmarshal(sid[1].Raw, &info.SerialNumber); err != nil {
+		return nil, err
+	}
+	if info.DigestAlgorithm, err = algorithm(fields[2]); err != nil {
+		return nil, err
+	}
+	rest := fields[3:]
+	if rest[0].Class == ClassContextSpecific && 0 == rest[0].Tag {
+		if err = info.parseSignedAttributes(rest[0]); err != nil {
+			return nil, err
+		}
+		rest = rest[1:]
+	}
+	if len(rest) < 2 || !rest[1].Is(TagOctetString) {
+		return nil, errors.New("receipt: malformed signer info")
+	}
+	if info.SignatureAlgorithm, err = algorithm(rest[0]); err != nil {
+		return nil, err
+	}
+	if info.Signature, err = rest[1].Bytes(); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (info *SignerInfo) parseSignedAttributes(v *BERValue) error {
+	attributes, err := v.Children()
+	if err != nil {
+		return err
+	}
+	for _, attribute := range attributes {
+		fields, err := sequence(attribute, 2)
+		if err != nil {
+			return err
+		}
+		var oid asn1.ObjectIdentifier
+		if _, err = asn1.Unmarshal(fields[0].Raw, &oid); err != nil {
+			return err
+		}
+		if !oid.Equal(oidMessageDigest) {
+			continue
+		}
+		values, err := fields[1].Children()
+		if err != nil || 1 != len(values) || !values[0].Is(TagOctetString) {
+			return errors.New("receipt: malformed message digest attribute")
+		}
+		if info.MessageDigest, err = values[0].Bytes(); err != nil {
+			return err
+		}
+	}
+	// The signature covers the DER encoding of the attributes with the SET OF tag, not the implicit [0] tag.
+	info.SignedAttributes = append([]byte{0x31}, v.Raw[1:]...)
+	return nil
+}
+
+// algorithm returns the OID of an AlgorithmIdentifier.
+func algorithm(v *BERValue) (asn1.ObjectIdentifier, error) {
+	fields, err := sequence(v, 1)
+	if err != nil {
+		return nil, err
+	}
+	var oid asn1.ObjectIdentifier
+	if _, err = asn1.Unmarshal(fields[0].Raw, &oid); err != nil {
+		return nil, err
+	}
+	return oid, nil
+}
+
+// ReceiptAttribute is one entry of the SET of attributes that makes up a receipt or an in-app purchase receipt.
+type ReceiptAttribute struct {
+	Type    int64
+	Version int64
+	// Value is the DER encoding of the attribute value.
+	Value []byte
+}
+
+// ParseReceiptAttributes decodes a receipt payload, a SET of ReceiptAttribute sequences.
+func ParseReceiptAttributes(payload []byte) ([]ReceiptAttribute, error) {
+	set, _, err := ParseBER(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !set.Is(TagSet) {
+		return nil, errors.New("receipt: payload is not a SET")
+	}
+	entries, err := set.Children()
+	if err != nil {
+		return nil, err
+	}
+	attributes := make([]ReceiptAttribute, 0, len(entries))
+	for _, entry := range entries {
+		fields, err := sequence(entry, 3)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := fields[0].Int()
+		if err != nil {
+			return nil, err
+		}
+		version, err := fields[1].Int()
+		if err != nil {
+			return nil, err
+		}
+		if !fields[2].Is(TagOctetString) {
+			return nil, errors.New("receipt: attribute value is not an OCTET STRING")
+		}
+		value, err := fields[2].Bytes()
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, ReceiptAttribute{Type: typ, Version: version, Value: value})
+	}
+	return attributes, nil
+}
+
+// String decodes an attribute value holding a UTF8String or an IA5String.
+func (a ReceiptAttribute) String() (string, error) {
+	v, _, err := ParseBER(a.Value)
+	if err != nil {
+		return "", err
+	}
+	if !v.Is(TagUTF8String) && !v.Is(TagIA5String) {
+		return "", errors.New("receipt: attribute value is not a string")
+	}
+	b, err := v.Bytes()
+	return string(b), err
+}
+
+// Int decodes an attribute value holding an INTEGER.
+func (a ReceiptAttribute) Int() (int64, error) {
+	v, _, err := ParseBER(a.Value)
+	if err != nil {
+		return 0, err
+	}
+	return v.Int()
+}
+
+// sequence returns the elements of a SEQUENCE, which must have at least minLen of them.
+func sequence(v *BERValue, minLen int) ([]*BERValue, error) {
+	if !v.Is(TagSequence) || !v.Constructed {
+		return nil, errors.New("ber: not a SEQUENCE")
+	}
+	children, err := v.Children()
+	if err != nil {
+		return nil, err
+	}
+	if len(children) < minLen {
+		return nil, errors.New("ber: SEQUENCE too short")
+	}
+	return children, nil
+}
+
+func (v *BERValue) isExplicit(tag int) bool {
+	return v.Class == ClassContextSpecific && v.Tag == tag && v.Constructed
+}
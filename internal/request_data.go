@@ -3,6 +3,9 @@ package internal
 type RequestData struct {
 	QueryParameters map[string][]string
 	Body            interface{}
+	// RawBody is sent as is with ContentType instead of the JSON encoded Body.
+	RawBody     []byte
+	ContentType string
 }
 
 type RequestDataOption = func(*RequestData)
@@ -18,3 +21,10 @@ func WithBody(body interface{}) RequestDataOption {
 		p.Body = body
 	}
 }
+
+func WithRawBody(contentType string, body []byte) RequestDataOption {
+	return func(p *RequestData) {
+		p.RawBody = body
+		p.ContentType = contentType
+	}
+}
@@ -0,0 +1,92 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+)
+
+const (
+	promotionalOfferAudience             = "promotional-offer"
+	introductoryOfferEligibilityAudience = "introductory-offer-eligibility"
+)
+
+// PromotionalOfferV2SignatureCreator
+// Creates the JWS signatures StoreKit 2 needs to apply promotional offers.
+// @see <a href="https://developer.apple.com/documentation/storekit/generating-jws-to-sign-app-store-requests">Generating JWS to sign App Store requests</a>
+type PromotionalOfferV2SignatureCreator struct {
+	creator *internal.JWSSignatureCreator
+}
+
+func NewPromotionalOfferV2SignatureCreatorWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId string) (*PromotionalOfferV2SignatureCreator, error) {
+	privateKey, err := PrivateKeyFromFile(privateKeyFilePath)
+	if nil != err {
+		return nil, err
+	}
+	return NewPromotionalOfferV2SignatureCreator(privateKey, keyId, issuer, bundleId), nil
+}
+
+func NewPromotionalOfferV2SignatureCreator(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *PromotionalOfferV2SignatureCreator {
+	return &PromotionalOfferV2SignatureCreator{creator: &internal.JWSSignatureCreator{
+		Audience: promotionalOfferAudience, KeyId: keyId, PrivateKey: privateKey, Issuer: issuer, BundleId: bundleId,
+	}}
+}
+
+// CreateSignature
+// Return the JWS of a promotional offer.
+// @param productId The unique identifier of the product.
+// @param offerIdentifier The promotional offer identifier that you set up in App Store Connect.
+// @param transactionId An optional transaction identifier of any transaction of the customer, which limits the signature to that customer.
+// @return The signed JWS.
+func (c *PromotionalOfferV2SignatureCreator) CreateSignature(productId, offerIdentifier, transactionId string) (string, error) {
+	if "" == productId || "" == offerIdentifier {
+		return "", errors.New("productId and offerIdentifier are required")
+	}
+	claims := map[string]interface{}{
+		"productId":       productId,
+		"offerIdentifier": offerIdentifier,
+	}
+	if "" != transactionId {
+		claims["transactionId"] = transactionId
+	}
+	return c.creator.Create(claims)
+}
+
+// IntroductoryOfferEligibilitySignatureCreator
+// Creates the JWS signatures StoreKit 2 uses to override the customer's eligibility for an introductory offer.
+// @see <a href="https://developer.apple.com/documentation/storekit/generating-jws-to-sign-app-store-requests">Generating JWS to sign App Store requests</a>
+type IntroductoryOfferEligibilitySignatureCreator struct {
+	creator *internal.JWSSignatureCreator
+}
+
+func NewIntroductoryOfferEligibilitySignatureCreatorWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId string) (*IntroductoryOfferEligibilitySignatureCreator, error) {
+	privateKey, err := PrivateKeyFromFile(privateKeyFilePath)
+	if nil != err {
+		return nil, err
+	}
+	return NewIntroductoryOfferEligibilitySignatureCreator(privateKey, keyId, issuer, bundleId), nil
+}
+
+func NewIntroductoryOfferEligibilitySignatureCreator(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *IntroductoryOfferEligibilitySignatureCreator {
+	return &IntroductoryOfferEligibilitySignatureCreator{creator: &internal.JWSSignatureCreator{
+		Audience: introductoryOfferEligibilityAudience, KeyId: keyId, PrivateKey: privateKey, Issuer: issuer, BundleId: bundleId,
+	}}
+}
+
+// CreateSignature
+// Return the JWS that sets whether the customer is eligible for the introductory offer of a product.
+// @param productId The unique identifier of the product.
+// @param allowIntroductoryOffer A Boolean value that determines whether the customer is eligible for an introductory offer.
+// @param transactionId The transaction identifier of any transaction of the customer.
+// @return The signed JWS.
+func (c *IntroductoryOfferEligibilitySignatureCreator) CreateSignature(productId string, allowIntroductoryOffer bool, transactionId string) (string, error) {
+	if "" == productId || "" == transactionId {
+		return "", errors.New("productId and transactionId are required")
+	}
+	return c.creator.Create(map[string]interface{}{
+		"productId":              productId,
+		"allowIntroductoryOffer": allowIntroductoryOffer,
+		"transactionId":          transactionId,
+	})
+}
@@ -0,0 +1,78 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/meetleev/go-apple-store-server/internal"
+)
+
+// verifyTestJWS verifies signature, checks the header and the common claims, and returns the claims.
+func verifyTestJWS(t *testing.T, signature string, publicKey *ecdsa.PublicKey, audience string) jwt.MapClaims {
+	t.Helper()
+	claims, err := internal.VerifyJWSSignature(signature, publicKey, audience)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(signature, jwt.MapClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if "keyId" != token.Header["kid"] || "ES256" != token.Header["alg"] {
+		t.Errorf("unexpected header %v", token.Header)
+	}
+	if audience != claims["aud"] || "com.example" != claims["bid"] || "issuerId" != claims["iss"] {
+		t.Errorf("unexpected claims %v", claims)
+	}
+	if nonce, _ := claims["nonce"].(string); !internal.IsUUID(nonce) {
+		t.Errorf("nonce %q is not a UUID", nonce)
+	}
+	return claims
+}
+
+func TestPromotionalOfferV2Signature(t *testing.T) {
+	key := newTestSigningKey(t)
+	creator := NewPromotionalOfferV2SignatureCreator(key, "keyId", "issuerId", "com.example")
+
+	signature, err := creator.CreateSignature("productId", "offerId", "transactionId")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := verifyTestJWS(t, signature, &key.PublicKey, "promotional-offer")
+	if "productId" != claims["productId"] || "offerId" != claims["offerIdentifier"] || "transactionId" != claims["transactionId"] {
+		t.Errorf("unexpected claims %v", claims)
+	}
+
+	signature, err = creator.CreateSignature("productId", "offerId", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := verifyTestJWS(t, signature, &key.PublicKey, "promotional-offer")["transactionId"]; ok {
+		t.Error("transactionId claim is set without a transaction id")
+	}
+
+	if _, err = creator.CreateSignature("productId", "", ""); err == nil {
+		t.Error("expected an error without an offer identifier")
+	}
+}
+
+func TestIntroductoryOfferEligibilitySignature(t *testing.T) {
+	key := newTestSigningKey(t)
+	creator := NewIntroductoryOfferEligibilitySignatureCreator(key, "keyId", "issuerId", "com.example")
+
+	for _, allow := range []bool{true, false} {
+		signature, err := creator.CreateSignature("productId", allow, "transactionId")
+		if err != nil {
+			t.Fatal(err)
+		}
+		claims := verifyTestJWS(t, signature, &key.PublicKey, "introductory-offer-eligibility")
+		if "productId" != claims["productId"] || allow != claims["allowIntroductoryOffer"] || "transactionId" != claims["transactionId"] {
+			t.Errorf("unexpected claims %v", claims)
+		}
+	}
+
+	if _, err := creator.CreateSignature("productId", true, ""); err == nil {
+		t.Error("expected an error without a transaction id")
+	}
+}
@@ -0,0 +1,113 @@
+package apple_store_server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	logger "github.com/sirupsen/logrus"
+)
+
+// DefaultCampaignPollInterval is used when a MassExtendRenewalDateCampaign doesn't set PollInterval.
+const DefaultCampaignPollInterval = time.Minute
+
+// MassExtendRenewalDateCampaign
+// A renewal-date extension for all eligible active subscribers of one product, tracked until the App Store completes it.
+type MassExtendRenewalDateCampaign struct {
+	// The product identifier of the auto-renewable subscription to extend.
+	ProductId string
+	// The number of days to extend the subscription renewal date. The maximum value is 90 days.
+	ExtendByDays int32
+	// The reason code for the subscription-renewal-date extension.
+	ExtendReasonCode types.ExtendReasonCode
+	// An optional list of storefront country codes that limits the storefronts of the extension.
+	StorefrontCountryCodes []string
+	// The UUID that identifies the campaign. Run generates one when it's empty and keeps it here,
+	// so a campaign interrupted while polling can be resumed with Wait.
+	RequestIdentifier string
+	// How often to check the status of the campaign. Defaults to DefaultCampaignPollInterval.
+	PollInterval time.Duration
+	// Called with every status the App Store reports while the campaign runs, may be nil.
+	OnStatus func(status *models.MassExtendRenewalDateStatusResponse)
+}
+
+// Run
+// Starts the campaign and waits until the App Store reports it complete or ctx is done.
+// @return The final status, including succeededCount and failedCount.
+func (m *MassExtendRenewalDateCampaign) Run(ctx context.Context, c *AppStoreServerAPIClient) (*models.MassExtendRenewalDateStatusResponse, error) {
+	if "" == m.RequestIdentifier {
+		requestIdentifier, err := internal.NewUUID()
+		if err != nil {
+			return nil, err
+		}
+		m.RequestIdentifier = requestIdentifier
+	}
+	request := &models.MassExtendRenewalDateRequest{
+		ExtendByDays:           m.ExtendByDays,
+		ExtendReasonCode:       m.ExtendReasonCode,
+		RequestIdentifier:      m.RequestIdentifier,
+		StorefrontCountryCodes: m.StorefrontCountryCodes,
+		ProductId:              m.ProductId,
+	}
+	if _, err := c.ExtendRenewalDateForAllActiveSubscribers(request); err != nil {
+		return nil, err
+	}
+	logger.Infof("mass renewal date extension started, productId:%s requestIdentifier:%s", m.ProductId, m.RequestIdentifier)
+	return m.Wait(ctx, c)
+}
+
+// Wait
+// Polls the status of a started campaign until the App Store reports it complete or ctx is done.
+// Transient errors, network failures and 429 or 5xx responses, are logged and retried at the next poll;
+// other errors end the wait. ctx is checked between polls only: the status requests use the client's own timeout
+// and are not cancelled by ctx.
+// @return The final status, including succeededCount and failedCount, or the last status seen when ctx is done.
+func (m *MassExtendRenewalDateCampaign) Wait(ctx context.Context, c *AppStoreServerAPIClient) (*models.MassExtendRenewalDateStatusResponse, error) {
+	if "" == m.RequestIdentifier {
+		return nil, errors.New("requestIdentifier is required")
+	}
+	interval := m.PollInterval
+	if interval <= 0 {
+		interval = DefaultCampaignPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var last *models.MassExtendRenewalDateStatusResponse
+	for {
+		status, err := c.GetStatusOfSubscriptionRenewalDateExtensions(m.ProductId, m.RequestIdentifier)
+		switch {
+		case nil == err:
+			last = status
+			if nil != m.OnStatus {
+				m.OnStatus(status)
+			}
+			if status.Complete {
+				return status, nil
+			}
+		case isTransientError(err):
+			logger.Warnf("get mass renewal date extension status failed, retrying [%v]", err)
+		default:
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTransientError reports whether a request may succeed if sent again: a network failure or a 429 or 5xx response.
+func isTransientError(err error) bool {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return http.StatusTooManyRequests == apiErr.HttpStatusCode || apiErr.HttpStatusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
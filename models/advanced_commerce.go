@@ -2,18 +2,24 @@ package models
 
 import "github.com/meetleev/go-apple-store-server/types"
 
-type advancedCommercePriceIncreaseInfo struct {
+// AdvancedCommercePriceIncreaseInfo
+// Information about the price increase of an Advanced Commerce item.
+type AdvancedCommercePriceIncreaseInfo struct {
 	DependentSKUs []string                                      `json:"dependentSKUs"`
 	Price         int64                                         `json:"price"`
 	Status        types.AdvancedCommercePriceIncreaseInfoStatus `json:"status"`
 }
 
-type advancedCommerceDescriptors struct {
+// AdvancedCommerceDescriptors
+// The display name and description of an Advanced Commerce subscription or item.
+type AdvancedCommerceDescriptors struct {
 	Description string `json:"description"`
 	DisplayName string `json:"displayName"`
 }
 
-type advancedCommerceOffer struct {
+// AdvancedCommerceOffer
+// A discount offer for an Advanced Commerce item.
+type AdvancedCommerceOffer struct {
 	Period      string `json:"period"`
 	PeriodCount int32  `json:"periodCount"`
 	Price       int64  `json:"price"`
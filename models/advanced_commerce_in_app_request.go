@@ -0,0 +1,205 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// AdvancedCommerceInAppRequestVersion is the version of the in-app request format.
+const AdvancedCommerceInAppRequestVersion = "1"
+
+var errInAppRequestRequired = errors.New("advanced commerce in-app request is required")
+
+// AdvancedCommerceSubscriptionCreateItem
+// An item of a new Advanced Commerce subscription.
+type AdvancedCommerceSubscriptionCreateItem struct {
+	SKU         string                 `json:"SKU"`
+	Description string                 `json:"description"`
+	DisplayName string                 `json:"displayName"`
+	Offer       *AdvancedCommerceOffer `json:"offer,omitempty"`
+	// The price, in milli units.
+	Price int64 `json:"price"`
+}
+
+// SubscriptionCreateRequest
+// The in-app request your app sends to create an Advanced Commerce subscription.
+// The App Store creates the subscription when the customer completes the purchase in the app, so there is no server call for it.
+type SubscriptionCreateRequest struct {
+	Operation   types.AdvancedCommerceOperation           `json:"operation"`
+	Version     string                                    `json:"version"`
+	RequestInfo *AdvancedCommerceRequestInfo              `json:"requestInfo"`
+	Currency    string                                    `json:"currency"`
+	Descriptors *AdvancedCommerceDescriptors              `json:"descriptors"`
+	Items       []*AdvancedCommerceSubscriptionCreateItem `json:"items"`
+	Period      types.AdvancedCommercePeriod              `json:"period"`
+	// The transaction identifier of a previous subscription of the customer, to grant continuity of service.
+	PreviousTransactionId string `json:"previousTransactionId,omitempty"`
+	Storefront            string `json:"storefront,omitempty"`
+	TaxCode               string `json:"taxCode"`
+}
+
+// NewSubscriptionCreateRequest returns a request with operation and version set.
+func NewSubscriptionCreateRequest(requestInfo *AdvancedCommerceRequestInfo) *SubscriptionCreateRequest {
+	return &SubscriptionCreateRequest{Operation: types.AdvancedCommerceOperationCreateSubscription, Version: AdvancedCommerceInAppRequestVersion, RequestInfo: requestInfo}
+}
+
+func (r *SubscriptionCreateRequest) Validate() error {
+	if nil == r {
+		return errInAppRequestRequired
+	}
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if 0 == len(r.Items) {
+		return errors.New("items are required")
+	}
+	if "" == r.Period || "" == r.Currency || "" == r.TaxCode {
+		return errors.New("period, currency and taxCode are required")
+	}
+	return nil
+}
+
+// AdvancedCommerceModifyAddItem
+// An item to add to an Advanced Commerce subscription.
+type AdvancedCommerceModifyAddItem struct {
+	SKU         string                 `json:"SKU"`
+	Description string                 `json:"description"`
+	DisplayName string                 `json:"displayName"`
+	Offer       *AdvancedCommerceOffer `json:"offer,omitempty"`
+	// The price, in milli units.
+	Price int64 `json:"price"`
+	// The price, in milli units, to charge for the rest of the current billing period.
+	ProratedPrice int64 `json:"proratedPrice,omitempty"`
+}
+
+// AdvancedCommerceModifyChangeItem
+// An item of an Advanced Commerce subscription to replace with another SKU, or to change the price of.
+type AdvancedCommerceModifyChangeItem struct {
+	// The SKU of the item to change.
+	CurrentSKU  string                          `json:"currentSKU"`
+	SKU         string                          `json:"SKU"`
+	Description string                          `json:"description"`
+	DisplayName string                          `json:"displayName"`
+	Effective   types.AdvancedCommerceEffective `json:"effective"`
+	Offer       *AdvancedCommerceOffer          `json:"offer,omitempty"`
+	// The price, in milli units.
+	Price int64 `json:"price"`
+	// The price, in milli units, to charge for the rest of the current billing period.
+	ProratedPrice int64 `json:"proratedPrice,omitempty"`
+	// Possible Values: UPGRADE DOWNGRADE APPLY_OFFER
+	Reason string `json:"reason"`
+}
+
+// AdvancedCommerceModifyRemoveItem
+// An item to remove from an Advanced Commerce subscription.
+type AdvancedCommerceModifyRemoveItem struct {
+	SKU string `json:"SKU"`
+}
+
+// AdvancedCommerceModifyDescriptors
+// The new display name and description of an Advanced Commerce subscription.
+type AdvancedCommerceModifyDescriptors struct {
+	Description string                          `json:"description"`
+	DisplayName string                          `json:"displayName"`
+	Effective   types.AdvancedCommerceEffective `json:"effective"`
+}
+
+// AdvancedCommerceModifyPeriodChange
+// The new period of an Advanced Commerce subscription.
+type AdvancedCommerceModifyPeriodChange struct {
+	Period    types.AdvancedCommercePeriod    `json:"period"`
+	Effective types.AdvancedCommerceEffective `json:"effective"`
+}
+
+// SubscriptionModifyInAppRequest
+// The in-app request your app sends to modify the items, descriptors or period of an Advanced Commerce subscription.
+type SubscriptionModifyInAppRequest struct {
+	Operation    types.AdvancedCommerceOperation     `json:"operation"`
+	Version      string                              `json:"version"`
+	RequestInfo  *AdvancedCommerceRequestInfo        `json:"requestInfo"`
+	AddItems     []*AdvancedCommerceModifyAddItem    `json:"addItems,omitempty"`
+	ChangeItems  []*AdvancedCommerceModifyChangeItem `json:"changeItems,omitempty"`
+	RemoveItems  []*AdvancedCommerceModifyRemoveItem `json:"removeItems,omitempty"`
+	Currency     string                              `json:"currency,omitempty"`
+	Descriptors  *AdvancedCommerceModifyDescriptors  `json:"descriptors,omitempty"`
+	PeriodChange *AdvancedCommerceModifyPeriodChange `json:"periodChange,omitempty"`
+	// A Boolean value that indicates whether to keep the current billing cycle.
+	RetainBillingCycle bool   `json:"retainBillingCycle"`
+	Storefront         string `json:"storefront,omitempty"`
+	TaxCode            string `json:"taxCode,omitempty"`
+	// The transaction identifier of the subscription to modify.
+	TransactionId string `json:"transactionId"`
+}
+
+// NewSubscriptionModifyInAppRequest returns a request with operation and version set.
+func NewSubscriptionModifyInAppRequest(requestInfo *AdvancedCommerceRequestInfo, transactionId string) *SubscriptionModifyInAppRequest {
+	return &SubscriptionModifyInAppRequest{Operation: types.AdvancedCommerceOperationModifySubscription, Version: AdvancedCommerceInAppRequestVersion, RequestInfo: requestInfo, TransactionId: transactionId}
+}
+
+func (r *SubscriptionModifyInAppRequest) Validate() error {
+	if nil == r {
+		return errInAppRequestRequired
+	}
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if "" == r.TransactionId {
+		return errors.New("transactionId is required")
+	}
+	return nil
+}
+
+// AdvancedCommerceOneTimeChargeItem
+// The item of an Advanced Commerce one-time charge.
+type AdvancedCommerceOneTimeChargeItem struct {
+	SKU         string `json:"SKU"`
+	Description string `json:"description"`
+	DisplayName string `json:"displayName"`
+	// The price, in milli units.
+	Price int64 `json:"price"`
+}
+
+// OneTimeChargeCreateRequest
+// The in-app request your app sends to charge the customer once for an Advanced Commerce item.
+type OneTimeChargeCreateRequest struct {
+	Operation   types.AdvancedCommerceOperation    `json:"operation"`
+	Version     string                             `json:"version"`
+	RequestInfo *AdvancedCommerceRequestInfo       `json:"requestInfo"`
+	Currency    string                             `json:"currency"`
+	Item        *AdvancedCommerceOneTimeChargeItem `json:"item"`
+	Storefront  string                             `json:"storefront,omitempty"`
+	TaxCode     string                             `json:"taxCode"`
+}
+
+// NewOneTimeChargeCreateRequest returns a request with operation and version set.
+func NewOneTimeChargeCreateRequest(requestInfo *AdvancedCommerceRequestInfo, item *AdvancedCommerceOneTimeChargeItem) *OneTimeChargeCreateRequest {
+	return &OneTimeChargeCreateRequest{Operation: types.AdvancedCommerceOperationCreateOneTimeCharge, Version: AdvancedCommerceInAppRequestVersion, RequestInfo: requestInfo, Item: item}
+}
+
+func (r *OneTimeChargeCreateRequest) Validate() error {
+	if nil == r {
+		return errInAppRequestRequired
+	}
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if nil == r.Item || "" == r.Item.SKU {
+		return errors.New("item with SKU is required")
+	}
+	if "" == r.Currency || "" == r.TaxCode {
+		return errors.New("currency and taxCode are required")
+	}
+	return nil
+}
+
+// AdvancedCommerceInAppRequest
+// An in-app request your server signs for the app: SubscriptionCreateRequest, SubscriptionModifyInAppRequest or OneTimeChargeCreateRequest.
+type AdvancedCommerceInAppRequest interface {
+	Validate() error
+	advancedCommerceInAppRequest()
+}
+
+func (r *SubscriptionCreateRequest) advancedCommerceInAppRequest()      {}
+func (r *SubscriptionModifyInAppRequest) advancedCommerceInAppRequest() {}
+func (r *OneTimeChargeCreateRequest) advancedCommerceInAppRequest()     {}
@@ -0,0 +1,246 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// AdvancedCommerceRequestInfo
+// The metadata to include in Advanced Commerce server and in-app requests.
+type AdvancedCommerceRequestInfo struct {
+	// A UUID you provide to uniquely identify the request. Reuse it when you retry the same request,
+	// so the App Store processes the operation only once.
+	RequestReferenceId string `json:"requestReferenceId"`
+	// A UUID that associates the transaction with a customer on your own service.
+	AppAccountToken string `json:"appAccountToken,omitempty"`
+	// The consistencyToken of the latest AdvancedCommerceRenewalInfo. The App Store rejects the request
+	// when the subscription changed since you read it.
+	ConsistencyToken string `json:"consistencyToken,omitempty"`
+}
+
+// NewAdvancedCommerceRequestInfo returns request metadata with a new requestReferenceId.
+// @param consistencyToken The consistencyToken of the latest renewal info of the subscription, may be empty.
+func NewAdvancedCommerceRequestInfo(consistencyToken string) (*AdvancedCommerceRequestInfo, error) {
+	requestReferenceId, err := internal.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &AdvancedCommerceRequestInfo{RequestReferenceId: requestReferenceId, ConsistencyToken: consistencyToken}, nil
+}
+
+func (r *AdvancedCommerceRequestInfo) Validate() error {
+	if nil == r {
+		return errors.New("requestInfo is required")
+	}
+	if !internal.IsUUID(r.RequestReferenceId) {
+		return errors.New("requestReferenceId must be a UUID")
+	}
+	if "" != r.AppAccountToken && !internal.IsUUID(r.AppAccountToken) {
+		return errors.New("appAccountToken must be a UUID")
+	}
+	return nil
+}
+
+// AdvancedCommerceItem
+// The SKU, display name and description of an Advanced Commerce item.
+type AdvancedCommerceItem struct {
+	SKU         string `json:"SKU"`
+	Description string `json:"description"`
+	DisplayName string `json:"displayName"`
+}
+
+// AdvancedCommerceSubscriptionResponse
+// A response that contains the signed transaction and renewal information of an Advanced Commerce subscription after a change.
+type AdvancedCommerceSubscriptionResponse struct {
+	// The transaction information signed by the App Store, in JWS format.
+	SignedTransactionInfo string `json:"signedTransactionInfo"`
+	// The subscription renewal information signed by the App Store, in JWS format.
+	SignedRenewalInfo string `json:"signedRenewalInfo"`
+}
+
+// AdvancedCommerceChangeMetadataDescriptors
+// The new display name and description of a subscription.
+type AdvancedCommerceChangeMetadataDescriptors struct {
+	Description string                          `json:"description"`
+	DisplayName string                          `json:"displayName"`
+	Effective   types.AdvancedCommerceEffective `json:"effective"`
+}
+
+// AdvancedCommerceChangeMetadataItem
+// The new metadata of an item in a subscription.
+type AdvancedCommerceChangeMetadataItem struct {
+	// The SKU of the item to change.
+	CurrentSKU string `json:"currentSKU"`
+	// The new SKU of the item.
+	SKU         string                          `json:"SKU"`
+	Description string                          `json:"description"`
+	DisplayName string                          `json:"displayName"`
+	Effective   types.AdvancedCommerceEffective `json:"effective"`
+}
+
+// SubscriptionChangeMetadataRequest
+// The request body you provide to change the metadata of an Advanced Commerce subscription.
+type SubscriptionChangeMetadataRequest struct {
+	RequestInfo *AdvancedCommerceRequestInfo               `json:"requestInfo"`
+	Descriptors *AdvancedCommerceChangeMetadataDescriptors `json:"descriptors,omitempty"`
+	Items       []*AdvancedCommerceChangeMetadataItem      `json:"items,omitempty"`
+	Storefront  string                                     `json:"storefront,omitempty"`
+	TaxCode     string                                     `json:"taxCode,omitempty"`
+}
+
+func (r *SubscriptionChangeMetadataRequest) Validate() error {
+	return r.RequestInfo.Validate()
+}
+
+// AdvancedCommercePriceChangeItem
+// The new price of an item in a subscription.
+type AdvancedCommercePriceChangeItem struct {
+	SKU string `json:"SKU"`
+	// The new price, in milli units.
+	Price         int64    `json:"price"`
+	DependentSKUs []string `json:"dependentSKUs,omitempty"`
+}
+
+// SubscriptionPriceChangeRequest
+// The request body you provide to change the price of items in an Advanced Commerce subscription.
+type SubscriptionPriceChangeRequest struct {
+	RequestInfo *AdvancedCommerceRequestInfo       `json:"requestInfo"`
+	Items       []*AdvancedCommercePriceChangeItem `json:"items"`
+	Currency    string                             `json:"currency,omitempty"`
+	Storefront  string                             `json:"storefront,omitempty"`
+}
+
+func (r *SubscriptionPriceChangeRequest) Validate() error {
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if 0 == len(r.Items) {
+		return errors.New("items are required")
+	}
+	return nil
+}
+
+// SubscriptionCancelRequest
+// The request body you provide to turn off automatic renewal of an Advanced Commerce subscription.
+type SubscriptionCancelRequest struct {
+	RequestInfo *AdvancedCommerceRequestInfo `json:"requestInfo"`
+	Storefront  string                       `json:"storefront,omitempty"`
+}
+
+func (r *SubscriptionCancelRequest) Validate() error {
+	return r.RequestInfo.Validate()
+}
+
+// SubscriptionRevokeRequest
+// The request body you provide to immediately end an Advanced Commerce subscription and refund it.
+type SubscriptionRevokeRequest struct {
+	RequestInfo  *AdvancedCommerceRequestInfo       `json:"requestInfo"`
+	RefundReason types.AdvancedCommerceRefundReason `json:"refundReason"`
+	// A Boolean value that indicates whether you accept the risk of refunding before Apple confirms the revocation.
+	RefundRiskingPreference bool                             `json:"refundRiskingPreference"`
+	RefundType              types.AdvancedCommerceRefundType `json:"refundType"`
+	Storefront              string                           `json:"storefront,omitempty"`
+}
+
+func (r *SubscriptionRevokeRequest) Validate() error {
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if "" == r.RefundReason || "" == r.RefundType {
+		return errors.New("refundReason and refundType are required")
+	}
+	return nil
+}
+
+// SubscriptionMigrateRequest
+// The request body you provide to migrate a subscription from In-App Purchase to an Advanced Commerce subscription.
+type SubscriptionMigrateRequest struct {
+	RequestInfo  *AdvancedCommerceRequestInfo `json:"requestInfo"`
+	Descriptors  *AdvancedCommerceDescriptors `json:"descriptors"`
+	Items        []*AdvancedCommerceItem      `json:"items"`
+	RenewalItems []*AdvancedCommerceItem      `json:"renewalItems,omitempty"`
+	Storefront   string                       `json:"storefront,omitempty"`
+	// The product identifier of the Advanced Commerce generic SKU to migrate to.
+	TargetProductId string `json:"targetProductId"`
+	TaxCode         string `json:"taxCode"`
+}
+
+func (r *SubscriptionMigrateRequest) Validate() error {
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if "" == r.TargetProductId {
+		return errors.New("targetProductId is required")
+	}
+	return nil
+}
+
+// AdvancedCommerceRefundItem
+// An Advanced Commerce item to refund.
+type AdvancedCommerceRefundItem struct {
+	SKU string `json:"SKU"`
+	// The amount to refund, in milli units. Required for the CUSTOM refund type only.
+	RefundAmount int64                              `json:"refundAmount,omitempty"`
+	RefundReason types.AdvancedCommerceRefundReason `json:"refundReason"`
+	RefundType   types.AdvancedCommerceRefundType   `json:"refundType"`
+	// A Boolean value that indicates whether to revoke the customer's access to the item.
+	Revoke bool `json:"revoke"`
+}
+
+func (i *AdvancedCommerceRefundItem) Validate() error {
+	if "" == i.SKU {
+		return errors.New("refund item SKU is required")
+	}
+	if "" == i.RefundReason {
+		return fmt.Errorf("refundReason is required for SKU %s", i.SKU)
+	}
+	switch i.RefundType {
+	case types.AdvancedCommerceRefundTypeFull, types.AdvancedCommerceRefundTypeProrated:
+		if 0 != i.RefundAmount {
+			return fmt.Errorf("refundAmount is only allowed with the CUSTOM refund type, SKU %s", i.SKU)
+		}
+	case types.AdvancedCommerceRefundTypeCustom:
+		if 0 >= i.RefundAmount {
+			return fmt.Errorf("refundAmount is required with the CUSTOM refund type, SKU %s", i.SKU)
+		}
+	default:
+		return fmt.Errorf("invalid refundType %q for SKU %s", i.RefundType, i.SKU)
+	}
+	return nil
+}
+
+// RequestRefundRequest
+// The request body you provide to request a refund of items in an Advanced Commerce transaction.
+type RequestRefundRequest struct {
+	RequestInfo *AdvancedCommerceRequestInfo  `json:"requestInfo"`
+	Items       []*AdvancedCommerceRefundItem `json:"items"`
+	Currency    string                        `json:"currency,omitempty"`
+	// A Boolean value that indicates whether you accept the risk of refunding before Apple confirms the refund.
+	RefundRiskingPreference bool   `json:"refundRiskingPreference"`
+	Storefront              string `json:"storefront,omitempty"`
+}
+
+func (r *RequestRefundRequest) Validate() error {
+	if err := r.RequestInfo.Validate(); err != nil {
+		return err
+	}
+	if 0 == len(r.Items) {
+		return errors.New("items are required")
+	}
+	for _, item := range r.Items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestRefundResponse
+// A response that contains the signed transaction after a refund request.
+type RequestRefundResponse struct {
+	// The transaction information signed by the App Store, in JWS format. Its advancedCommerceInfo items carry the refunds.
+	SignedTransactionInfo string `json:"signedTransactionInfo"`
+}
@@ -0,0 +1,76 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+const sandboxReceiptType = "ProductionSandbox"
+
+// AppReceipt
+// The decoded fields of a StoreKit 1 app receipt. Dates are UNIX times in milliseconds, zero when absent.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts">App Store Receipts</a>
+type AppReceipt struct {
+	// The type of receipt, Production or ProductionSandbox.
+	ReceiptType string `json:"receiptType"`
+	// The app’s bundle identifier.
+	BundleId string `json:"bundleId"`
+	// The app’s version number, CFBundleVersion on iOS or CFBundleShortVersionString on macOS.
+	ApplicationVersion string `json:"applicationVersion"`
+	// An opaque value used, with other data, to compute the SHA-1 hash during validation.
+	OpaqueValue []byte `json:"opaqueValue"`
+	// A SHA-1 hash, used to validate the receipt.
+	SHA1Hash []byte `json:"sha1Hash"`
+	// The date when the app receipt was created.
+	ReceiptCreationDate int64 `json:"receiptCreationDate"`
+	// The date the customer originally purchased the app.
+	OriginalPurchaseDate int64 `json:"originalPurchaseDate"`
+	// The version of the app that the customer originally purchased.
+	OriginalApplicationVersion string `json:"originalApplicationVersion"`
+	// The date that the app receipt expires, only present for apps purchased through the Volume Purchase Program.
+	ExpirationDate int64 `json:"expirationDate"`
+	// The receipts of the in-app purchases.
+	InApp []*InAppReceipt `json:"inApp"`
+
+	// The DER encoding of the bundle identifier, used to compute the SHA-1 hash.
+	BundleIdData []byte `json:"-"`
+}
+
+func (a *AppReceipt) BundleID() string {
+	return a.BundleId
+}
+
+// EnvironmentValue maps the receipt type to Sandbox or Production.
+func (a *AppReceipt) EnvironmentValue() string {
+	if sandboxReceiptType == a.ReceiptType {
+		return types.EnvSandbox
+	}
+	return types.EnvProduction
+}
+
+// InAppReceipt
+// The decoded fields of an in-app purchase receipt. Dates are UNIX times in milliseconds, zero when absent.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/responsebody/receipt/in_app">in_app</a>
+type InAppReceipt struct {
+	// The number of consumable products purchased.
+	Quantity int64 `json:"quantity"`
+	// The product identifier of the item that was purchased.
+	ProductId string `json:"productId"`
+	// The transaction identifier of the item that was purchased.
+	TransactionId string `json:"transactionId"`
+	// For a transaction that restores a previous transaction, the transaction identifier of the original transaction.
+	OriginalTransactionId string `json:"originalTransactionId"`
+	// The date and time that the item was purchased.
+	PurchaseDate int64 `json:"purchaseDate"`
+	// For a transaction that restores a previous transaction, the date of the original transaction.
+	OriginalPurchaseDate int64 `json:"originalPurchaseDate"`
+	// The expiration date for the subscription.
+	ExpiresDate int64 `json:"expiresDate"`
+	// For a transaction that was canceled by Apple customer support, the time and date of the cancellation.
+	CancellationDate int64 `json:"cancellationDate"`
+	// The primary key for identifying subscription purchases.
+	WebOrderLineItemId int64 `json:"webOrderLineItemId"`
+	// Whether the subscription is in the free trial period.
+	IsTrialPeriod bool `json:"isTrialPeriod"`
+	// Whether the subscription is in an introductory price period.
+	IsInIntroOfferPeriod bool `json:"isInIntroOfferPeriod"`
+	// The identifier of the subscription offer redeemed by the user.
+	PromotionalOfferId string `json:"promotionalOfferId"`
+}
@@ -56,3 +56,10 @@ func (a *AppTransactionDecodedPayload) EnvironmentValue() string {
 	}
 	return string(a.Environment)
 }
+
+func (a *AppTransactionDecodedPayload) AppAppleID() int64 {
+	if a == nil {
+		return 0
+	}
+	return int64(a.AppAppleId)
+}
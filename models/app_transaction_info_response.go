@@ -0,0 +1,8 @@
+package models
+
+// AppTransactionInfoResponse
+// A response that contains signed app transaction information for a customer.
+type AppTransactionInfoResponse struct {
+	// A customer’s app transaction information, signed by Apple, in JSON Web Signature (JWS) format.
+	SignedAppTransactionInfo string `json:"signedAppTransactionInfo"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// ConsumptionRequest
+// The request body containing consumption information.
+type ConsumptionRequest struct {
+	// A Boolean value that indicates whether the customer consented to provide consumption data to the App Store.
+	CustomerConsented bool `json:"customerConsented"`
+	// A value that indicates the extent to which the customer consumed the in-app purchase.
+	ConsumptionStatus types.ConsumptionStatus `json:"consumptionStatus"`
+	// A value that indicates the platform on which the customer consumed the in-app purchase.
+	Platform types.Platform `json:"platform"`
+	// A Boolean value that indicates whether you provided, prior to its purchase, a free sample or trial of the content, or information about its functionality.
+	SampleContentProvided bool `json:"sampleContentProvided"`
+	// A value that indicates whether the app successfully delivered an in-app purchase that works properly.
+	DeliveryStatus types.DeliveryStatus `json:"deliveryStatus"`
+	// The UUID that an app optionally generates to map a customer’s in-app purchase with its resulting App Store transaction.
+	AppAccountToken string `json:"appAccountToken"`
+	// The age of the customer’s account.
+	AccountTenure types.AccountTenure `json:"accountTenure"`
+	// A value that indicates the amount of time that the customer used the app.
+	PlayTime types.PlayTime `json:"playTime"`
+	// A value that indicates the total amount, in USD, of refunds the customer has received, in your app, across all platforms.
+	LifetimeDollarsRefunded types.LifetimeDollarsRefunded `json:"lifetimeDollarsRefunded"`
+	// A value that indicates the total amount, in USD, of in-app purchases the customer has made in your app, across all platforms.
+	LifetimeDollarsPurchased types.LifetimeDollarsPurchased `json:"lifetimeDollarsPurchased"`
+	// The status of the customer’s account.
+	UserStatus types.UserStatus `json:"userStatus"`
+	// A value that indicates your preference, based on your operational logic, as to whether Apple should grant the refund.
+	RefundPreference types.RefundPreference `json:"refundPreference"`
+}
+
+func (r *ConsumptionRequest) Validate() error {
+	if !r.CustomerConsented {
+		return errors.New("consumption data requires customerConsented to be true")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+const (
+	// MaxExtendByDays The maximum number of days a single request can extend a subscription renewal date.
+	MaxExtendByDays = 90
+	// MaxRequestIdentifierLength The maximum length of a renewal-date extension request identifier.
+	MaxRequestIdentifierLength = 128
+)
+
+// ExtendRenewalDateRequest
+// The request body that contains subscription-renewal-extension data for an individual subscription.
+type ExtendRenewalDateRequest struct {
+	// The number of days to extend the subscription renewal date. The maximum value is 90 days.
+	ExtendByDays int32 `json:"extendByDays"`
+	// The reason code for the subscription date extension
+	ExtendReasonCode types.ExtendReasonCode `json:"extendReasonCode"`
+	// A string that contains a unique identifier you provide to track each subscription-renewal-date extension request.
+	RequestIdentifier string `json:"requestIdentifier"`
+}
+
+func (r *ExtendRenewalDateRequest) Validate() error {
+	return validateExtension(r.ExtendByDays, r.ExtendReasonCode, r.RequestIdentifier)
+}
+
+func validateExtension(extendByDays int32, extendReasonCode types.ExtendReasonCode, requestIdentifier string) error {
+	if extendByDays < 1 || extendByDays > MaxExtendByDays {
+		return fmt.Errorf("extendByDays must be between 1 and %d, got %d", MaxExtendByDays, extendByDays)
+	}
+	if extendReasonCode < types.ExtendReasonCodeUndeclared || extendReasonCode > types.ExtendReasonCodeServiceIssueOrOutage {
+		return fmt.Errorf("invalid extendReasonCode %d", extendReasonCode)
+	}
+	if "" == requestIdentifier {
+		return errors.New("requestIdentifier is required")
+	}
+	if len(requestIdentifier) > MaxRequestIdentifierLength {
+		return fmt.Errorf("requestIdentifier must be at most %d characters", MaxRequestIdentifierLength)
+	}
+	return nil
+}
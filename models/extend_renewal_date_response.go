@@ -0,0 +1,14 @@
+package models
+
+// ExtendRenewalDateResponse
+// A response that indicates whether an individual renewal-date extension succeeded, and related details.
+type ExtendRenewalDateResponse struct {
+	// The original transaction identifier of a purchase.
+	OriginalTransactionId string `json:"originalTransactionId"`
+	// The unique identifier of subscription-purchase events across devices, including renewals.
+	WebOrderLineItemId string `json:"webOrderLineItemId"`
+	// A Boolean value that indicates whether the subscription-renewal-date extension succeeded.
+	Success bool `json:"success"`
+	// The new subscription expiration date for a subscription-renewal extension, in UNIX time, in milliseconds.
+	EffectiveDate int64 `json:"effectiveDate"`
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// ExternalPurchaseSubscriptionPeriod
+// The period of a subscription that a line item of an external purchase report covers.
+type ExternalPurchaseSubscriptionPeriod struct {
+	// The start date of the period, in UNIX time, in milliseconds.
+	StartDate int64 `json:"startDate"`
+	// The end date of the period, in UNIX time, in milliseconds.
+	EndDate int64 `json:"endDate"`
+	// The duration of a subscription cycle, in ISO 8601 duration format.
+	Period string `json:"period,omitempty"`
+}
+
+// ExternalPurchaseLineItem
+// A purchase or refund the customer made with an external purchase token.
+type ExternalPurchaseLineItem struct {
+	// A unique identifier you provide for the line item.
+	LineItemId string `json:"lineItemId"`
+	// For a refund, the lineItemId of the purchase you refunded.
+	OriginalLineItemId string                          `json:"originalLineItemId,omitempty"`
+	EventType          types.ExternalPurchaseEventType `json:"eventType"`
+	// The date of the event, in UNIX time, in milliseconds.
+	EventDate   int64                             `json:"eventDate"`
+	ProductType types.ExternalPurchaseProductType `json:"productType"`
+	Quantity    int32                             `json:"quantity"`
+	// The three-letter ISO 4217 currency code of the amounts.
+	Currency string `json:"currency"`
+	// The tax-exclusive price, in milli units.
+	TaxExclusivePrice int64 `json:"taxExclusivePrice"`
+	// The tax amount, in milli units.
+	TaxAmount int64 `json:"taxAmount"`
+	// The two-letter ISO 3166-1 code of the country or region the tax applies to.
+	TaxCountry         string                              `json:"taxCountry"`
+	SubscriptionPeriod *ExternalPurchaseSubscriptionPeriod `json:"subscriptionPeriod,omitempty"`
+}
+
+// ExternalPurchaseReport
+// The report of the external purchases made with one external purchase token.
+type ExternalPurchaseReport struct {
+	// A UUID you provide to uniquely identify the report.
+	RequestIdentifier string `json:"requestIdentifier"`
+	// The externalPurchaseId of the external purchase token.
+	ExternalPurchaseId string                          `json:"externalPurchaseId"`
+	TokenType          types.ExternalPurchaseTokenType `json:"tokenType"`
+	LineItems          []*ExternalPurchaseLineItem     `json:"lineItems"`
+	// A Boolean value you set to true when the customer made no purchase with the token.
+	NoLineItems bool `json:"noLineItems,omitempty"`
+}
+
+func (r *ExternalPurchaseReport) Validate() error {
+	if !internal.IsUUID(r.RequestIdentifier) {
+		return errors.New("requestIdentifier must be a UUID")
+	}
+	if "" == r.ExternalPurchaseId {
+		return errors.New("externalPurchaseId is required")
+	}
+	if r.NoLineItems != (0 == len(r.LineItems)) {
+		return errors.New("provide lineItems, or set noLineItems when there are none")
+	}
+	for _, item := range r.LineItems {
+		if "" == item.LineItemId {
+			return errors.New("lineItemId is required")
+		}
+		if types.ExternalPurchaseEventTypeRefund == item.EventType && "" == item.OriginalLineItemId {
+			return fmt.Errorf("refund line item %s requires originalLineItemId", item.LineItemId)
+		}
+		if types.ExternalPurchaseProductTypeSubscription == item.ProductType && nil == item.SubscriptionPeriod {
+			return fmt.Errorf("subscription line item %s requires subscriptionPeriod", item.LineItemId)
+		}
+	}
+	return nil
+}
+
+// ExternalPurchaseReportResponse
+// A response that indicates Apple received an external purchase report.
+type ExternalPurchaseReportResponse struct {
+	RequestIdentifier string `json:"requestIdentifier"`
+}
+
+// ExternalPurchaseReportError
+// An error Apple found while processing an external purchase report.
+type ExternalPurchaseReportError struct {
+	// The line item the error applies to, empty when it applies to the whole report.
+	LineItemId   string                          `json:"lineItemId,omitempty"`
+	ErrorCode    types.ExternalPurchaseErrorCode `json:"errorCode"`
+	ErrorMessage string                          `json:"errorMessage"`
+}
+
+// ExternalPurchaseReportStatusResponse
+// A response that contains the processing status of an external purchase report.
+type ExternalPurchaseReportStatusResponse struct {
+	RequestIdentifier string                             `json:"requestIdentifier"`
+	Status            types.ExternalPurchaseReportStatus `json:"status"`
+	Errors            []*ExternalPurchaseReportError     `json:"errors,omitempty"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+const sandboxExternalPurchaseIdPrefix = "SANDBOX"
+
+// ExternalPurchaseToken
+// The payload data that contains an external purchase token.
+type ExternalPurchaseToken struct {
+	// The field of an external purchase token that uniquely identifies the token.
+	ExternalPurchaseId string `json:"externalPurchaseId"`
+	// The field of an external purchase token that contains the UNIX date, in milliseconds, when the system created the token.
+	TokenCreationDate int64 `json:"tokenCreationDate"`
+	// The unique identifier of an app in the App Store.
+	AppAppleId int64 `json:"appAppleId"`
+	// The bundle identifier of an app.
+	BundleId string `json:"bundleId"`
+}
+
+func (e *ExternalPurchaseToken) BundleID() string {
+	return e.BundleId
+}
+
+// EnvironmentValue derives the environment from the externalPurchaseId, which starts with SANDBOX for sandbox tokens.
+func (e *ExternalPurchaseToken) EnvironmentValue() string {
+	if strings.HasPrefix(e.ExternalPurchaseId, sandboxExternalPurchaseIdPrefix) {
+		return types.EnvSandbox
+	}
+	return types.EnvProduction
+}
+
+func (e *ExternalPurchaseToken) AppAppleID() int64 {
+	return e.AppAppleId
+}
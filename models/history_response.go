@@ -0,0 +1,20 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// HistoryResponse
+// A response that contains the customer’s transaction history for an app.
+type HistoryResponse struct {
+	// A token you use in a query to request the next set of transactions for the customer.
+	Revision string `json:"revision"`
+	// A Boolean value indicating whether the App Store has more transaction data.
+	HasMore bool `json:"hasMore"`
+	// The bundle identifier of an app.
+	BundleId string `json:"bundleId"`
+	// The unique identifier of an app in the App Store.
+	AppAppleId int64 `json:"appAppleId"`
+	// The server environment in which you’re making the request, whether sandbox or production.
+	Environment types.Environment `json:"environment"`
+	// An array of in-app purchase transactions for the customer, signed by Apple, in JSON Web Signature format.
+	SignedTransactions []string `json:"signedTransactions"`
+}
@@ -10,19 +10,23 @@ type renewalCommitmentInfo struct {
 	RenewalPrice           int64                 `json:"commitmentRenewalPrice,omitempty"`
 }
 
-type advancedCommerceRenewalItem struct {
+// AdvancedCommerceRenewalItem
+// An Advanced Commerce item that renews at the next billing period.
+type AdvancedCommerceRenewalItem struct {
 	SKU               string                             `json:"SKU"`
 	Description       string                             `json:"description"`
 	DisplayName       string                             `json:"displayName"`
-	Offer             *advancedCommerceOffer             `json:"offer"`
+	Offer             *AdvancedCommerceOffer             `json:"offer"`
 	Price             int64                              `json:"price"`
-	PriceIncreaseInfo *advancedCommercePriceIncreaseInfo `json:"priceIncreaseInfo,omitempty"`
+	PriceIncreaseInfo *AdvancedCommercePriceIncreaseInfo `json:"priceIncreaseInfo,omitempty"`
 }
 
-type advancedCommerceRenewalInfo struct {
+// AdvancedCommerceRenewalInfo
+// Renewal information that is present only for Advanced Commerce SKUs.
+type AdvancedCommerceRenewalInfo struct {
 	ConsistencyToken   string                         `json:"consistencyToken"`
-	Descriptors        *advancedCommerceDescriptors   `json:"descriptors,omitempty"`
-	Items              []*advancedCommerceRenewalItem `json:"items,omitempty"`
+	Descriptors        *AdvancedCommerceDescriptors   `json:"descriptors,omitempty"`
+	Items              []*AdvancedCommerceRenewalItem `json:"items,omitempty"`
 	Period             string                         `json:"period,omitempty"`
 	RequestReferenceId string                         `json:"requestReferenceId,omitempty"`
 	TaxCode            string                         `json:"taxCode,omitempty"`
@@ -75,7 +79,7 @@ type JWSRenewalInfoDecodedPayload struct {
 	// The UNIX time, in milliseconds, that the App Store signed the JSON Web Signature (JWS) data.
 	SignedDate int64 `json:"signedDate"`
 
-	AdvancedCommerceInfo *advancedCommerceRenewalInfo `json:"advancedCommerceInfo,omitempty"`
+	AdvancedCommerceInfo *AdvancedCommerceRenewalInfo `json:"advancedCommerceInfo,omitempty"`
 	CommitmentInfo       *renewalCommitmentInfo       `json:"commitmentInfo,omitempty"`
 	/*
 		Possible Values:
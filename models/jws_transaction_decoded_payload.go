@@ -21,42 +21,33 @@ type transactionCommitmentInfo struct {
 	TotalBillingPeriods   int32 `json:"totalBillingPeriods"`
 }
 
-type advancedCommerceRefund struct {
-	RefundAmount int64 `json:"refundAmount"`
-	RefundDate   int64 `json:"refundDate"`
-	/*
-		Possible Values
-		UNINTENDED_PURCHASE
-		FULFILLMENT_ISSUE
-		UNSATISFIED_WITH_PURCHASE
-		LEGAL
-		OTHER
-		MODIFY_ITEMS_REFUND
-		SIMULATE_REFUND_DECLINE
-	*/
-	RefundReason string `json:"refundReason"`
-	/*
-		Possible Values:
-		FULL
-		PRORATED
-		CUSTOM
-	*/
-	RefundType string `json:"refundType"`
+// AdvancedCommerceRefund
+// A refund of an Advanced Commerce item.
+type AdvancedCommerceRefund struct {
+	RefundAmount int64                              `json:"refundAmount"`
+	RefundDate   int64                              `json:"refundDate"`
+	RefundReason types.AdvancedCommerceRefundReason `json:"refundReason"`
+	RefundType   types.AdvancedCommerceRefundType   `json:"refundType"`
 }
 
-type advancedCommerceTransactionItem struct {
+// AdvancedCommerceTransactionItem
+// An Advanced Commerce item in a transaction.
+type AdvancedCommerceTransactionItem struct {
 	SKU            string                    `json:"SKU"`
 	Description    string                    `json:"description"`
 	DisplayName    string                    `json:"displayName"`
-	Offer          *advancedCommerceOffer    `json:"offer"`
+	Offer          *AdvancedCommerceOffer    `json:"offer"`
 	Price          int64                     `json:"price"`
-	Refunds        []*advancedCommerceRefund `json:"refunds"`
+	Refunds        []*AdvancedCommerceRefund `json:"refunds"`
 	RevocationDate int64                     `json:"revocationDate"`
 }
-type advancedCommerceTransactionInfo struct {
-	Descriptors        *advancedCommerceDescriptors       `json:"descriptors"`
+
+// AdvancedCommerceTransactionInfo
+// Transaction information that is present only for Advanced Commerce SKUs.
+type AdvancedCommerceTransactionInfo struct {
+	Descriptors        *AdvancedCommerceDescriptors       `json:"descriptors"`
 	EstimatedTax       int64                              `json:"estimatedTax"`
-	Items              []*advancedCommerceTransactionItem `json:"items"`
+	Items              []*AdvancedCommerceTransactionItem `json:"items"`
 	Period             string                             `json:"period"`
 	RequestReferenceId string                             `json:"requestReferenceId"`
 	TaxCode            string                             `json:"taxCode"`
@@ -81,7 +72,7 @@ type JWSTransactionDecodedPayload struct {
 	// The UNIX time, in milliseconds, that the subscription expires or renews.
 	ExpiresDate int64 `json:"expiresDate"`
 	// A string that describes whether the transaction was purchased by the customer, or is available to them through Family Sharing.
-	InAppOwnershipType string `json:"inAppOwnershipType"`
+	InAppOwnershipType types.InAppOwnershipType `json:"inAppOwnershipType"`
 	// A Boolean value that indicates whether the customer upgraded to another subscription.
 	IsUpgraded bool `json:"isUpgraded"`
 	// The payment mode you configure for the subscription offer, such as Free Trial, Pay As You Go, or Pay Up Front.
@@ -127,7 +118,7 @@ type JWSTransactionDecodedPayload struct {
 	// The unique identifier of subscription purchase events across devices, including subscription renewals.
 	WebOrderLineItemId string `json:"webOrderLineItemId"`
 	// Transaction information that is present only for Advanced Commerce SKUs.
-	AdvancedCommerceInfo *advancedCommerceTransactionInfo `json:"advancedCommerceInfo,omitempty"`
+	AdvancedCommerceInfo *AdvancedCommerceTransactionInfo `json:"advancedCommerceInfo,omitempty"`
 	// Possible Values: BILLED_UPFRONT, MONTHLY
 	BillingPlanType string `json:"billingPlanType,omitempty"`
 
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// MassExtendRenewalDateRequest
+// The request body that contains subscription-renewal-extension data to apply for all eligible active subscribers.
+type MassExtendRenewalDateRequest struct {
+	// The number of days to extend the subscription renewal date. The maximum value is 90 days.
+	ExtendByDays int32 `json:"extendByDays"`
+	// The reason code for the subscription-renewal-date extension.
+	ExtendReasonCode types.ExtendReasonCode `json:"extendReasonCode"`
+	// A string that contains a unique identifier you provide to track each subscription-renewal-date extension request.
+	RequestIdentifier string `json:"requestIdentifier"`
+	// A list of storefront country codes you provide to limit the storefronts for a subscription-renewal-date extension.
+	StorefrontCountryCodes []string `json:"storefrontCountryCodes,omitempty"`
+	// The unique identifier for the product, that you create in App Store Connect.
+	ProductId string `json:"productId"`
+}
+
+func (r *MassExtendRenewalDateRequest) Validate() error {
+	if err := validateExtension(r.ExtendByDays, r.ExtendReasonCode, r.RequestIdentifier); err != nil {
+		return err
+	}
+	if "" == r.ProductId {
+		return errors.New("productId is required")
+	}
+	return nil
+}
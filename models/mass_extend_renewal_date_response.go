@@ -0,0 +1,23 @@
+package models
+
+// MassExtendRenewalDateResponse
+// A response that indicates the server successfully received the subscription-renewal-date extension request.
+type MassExtendRenewalDateResponse struct {
+	// A string that contains a unique identifier you provide to track each subscription-renewal-date extension request.
+	RequestIdentifier string `json:"requestIdentifier"`
+}
+
+// MassExtendRenewalDateStatusResponse
+// A response that indicates the current status of a request to extend the subscription renewal date to all eligible subscribers.
+type MassExtendRenewalDateStatusResponse struct {
+	// A string that contains a unique identifier you provide to track each subscription-renewal-date extension request.
+	RequestIdentifier string `json:"requestIdentifier"`
+	// A Boolean value that indicates whether the App Store completed the request to extend a subscription renewal date to active subscribers.
+	Complete bool `json:"complete"`
+	// The UNIX time, in milliseconds, that the App Store completes a request to extend a subscription renewal date for eligible subscribers.
+	CompleteDate int64 `json:"completeDate"`
+	// The count of subscriptions that successfully receive a subscription-renewal-date extension.
+	SucceededCount int64 `json:"succeededCount"`
+	// The count of subscriptions that fail to receive a subscription-renewal-date extension.
+	FailedCount int64 `json:"failedCount"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// NotificationHistoryRequest
+// The request body for notification history.
+type NotificationHistoryRequest struct {
+	// The start date of the timespan for the requested App Store Server Notification history records, in UNIX time, in milliseconds.
+	// The startDate needs to precede the endDate. Choose a startDate that’s within the past 180 days from the current date.
+	StartDate int64 `json:"startDate"`
+	// The end date of the timespan for the requested App Store Server Notification history records, in UNIX time, in milliseconds.
+	EndDate int64 `json:"endDate"`
+	// A notification type. Provide this field to limit the notification history records to those with this one notification type.
+	// Include either the transactionId or the notificationType in your query, but not both.
+	NotificationType types.NotificationTypeV2 `json:"notificationType,omitempty"`
+	// A notification subtype. Provide this field to limit the notification history records to those with this one notification subtype.
+	// If you specify a notificationSubtype, you need to also specify its related notificationType.
+	NotificationSubtype types.Subtype `json:"notificationSubtype,omitempty"`
+	// The transaction identifier, which may be an original transaction identifier, of any transaction belonging to the customer.
+	// Include either the transactionId or the notificationType in your query, but not both.
+	TransactionId string `json:"transactionId,omitempty"`
+	// A Boolean value you set to true to request only the notifications that haven’t reached your server successfully.
+	OnlyFailures bool `json:"onlyFailures,omitempty"`
+}
+
+func (r *NotificationHistoryRequest) Validate() error {
+	if 0 >= r.StartDate || 0 >= r.EndDate {
+		return errors.New("startDate and endDate are required")
+	}
+	if r.StartDate >= r.EndDate {
+		return errors.New("startDate needs to precede endDate")
+	}
+	if "" != r.NotificationType && "" != r.TransactionId {
+		return errors.New("include either the transactionId or the notificationType, but not both")
+	}
+	if "" != r.NotificationSubtype && "" == r.NotificationType {
+		return errors.New("notificationSubtype requires notificationType")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// SendAttemptItem
+// The success or error information and the date the App Store server records when it attempts to send a server notification to your server.
+type SendAttemptItem struct {
+	// The date the App Store server attempts to send a notification, in UNIX time, in milliseconds.
+	AttemptDate int64 `json:"attemptDate"`
+	// The success or error information the App Store server records when it attempts to send an App Store server notification to your server.
+	SendAttemptResult types.SendAttemptResult `json:"sendAttemptResult"`
+}
+
+// NotificationHistoryResponseItem
+// The App Store server notification history record, including the signed notification payload and the result of the server’s first send attempt.
+type NotificationHistoryResponseItem struct {
+	// A cryptographically signed payload, in JSON Web Signature (JWS) format, containing the response body for a version 2 notification.
+	SignedPayload string `json:"signedPayload"`
+	// An array of information the App Store server records for its attempts to send a notification to your server. The maximum number of entries in the array is six.
+	SendAttempts []*SendAttemptItem `json:"sendAttempts"`
+}
+
+// NotificationHistoryResponse
+// A response that contains the App Store Server Notifications history for your app.
+type NotificationHistoryResponse struct {
+	// A pagination token that you return to the endpoint on a subsequent call to receive the next set of results.
+	PaginationToken string `json:"paginationToken"`
+	// A Boolean value indicating whether the App Store has more transaction data.
+	HasMore bool `json:"hasMore"`
+	// An array of App Store server notification history records.
+	NotificationHistory []*NotificationHistoryResponseItem `json:"notificationHistory"`
+}
@@ -0,0 +1,133 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+const productionEnvironmentV1 = "PROD"
+
+// NotificationV1
+// The JSON body the App Store sends in a version 1 server notification.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/responsebodyv1">responseBodyV1</a>
+type NotificationV1 struct {
+	// An identifier that App Store Connect generates and the App Store uses to uniquely identify the auto-renewable subscription that the user’s subscription renews.
+	AutoRenewAdamId string `json:"auto_renew_adam_id"`
+	// The product identifier of the auto-renewable subscription that the user’s subscription renews.
+	AutoRenewProductId string `json:"auto_renew_product_id"`
+	// The current renewal status for an auto-renewable subscription product.
+	AutoRenewStatus LegacyBool `json:"auto_renew_status"`
+	// The time at which the user turned on or off the renewal status for an auto-renewable subscription, in UNIX epoch time format, in milliseconds.
+	AutoRenewStatusChangeDateMs LegacyInt64 `json:"auto_renew_status_change_date_ms"`
+	// The environment for which the App Store generated the receipt, Sandbox or PROD.
+	Environment string `json:"environment"`
+	// The reason a subscription expired.
+	ExpirationIntent LegacyInt64 `json:"expiration_intent"`
+	// The subscription event that triggered the notification.
+	NotificationType types.NotificationTypeV1 `json:"notification_type"`
+	// The same value as the shared secret you submit in the password field of the requestBody when validating receipts.
+	Password string `json:"password"`
+	// An object that contains information about the most-recent, in-app purchase transactions for the app.
+	UnifiedReceipt *UnifiedReceipt `json:"unified_receipt"`
+	// A string that contains the app bundle ID.
+	Bid string `json:"bid"`
+	// A string that contains the app bundle version.
+	Bvrs string `json:"bvrs"`
+	// The original transaction identifier of the purchase.
+	OriginalTransactionId string `json:"original_transaction_id"`
+}
+
+// UnifiedReceipt
+// The most-recent in-app purchase transactions of a version 1 notification.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/unified_receipt">unified_receipt</a>
+type UnifiedReceipt struct {
+	// The environment for which the receipt was generated, Sandbox or Production.
+	Environment types.Environment `json:"environment"`
+	// The latest Base64-encoded app receipt.
+	LatestReceipt string `json:"latest_receipt"`
+	// An array that contains the latest 100 in-app purchase transactions of the app.
+	LatestReceiptInfo []*LegacyInAppTransaction `json:"latest_receipt_info"`
+	// An array where each element contains the pending renewal information for each auto-renewable subscription.
+	PendingRenewalInfo []*LegacyPendingRenewalInfo `json:"pending_renewal_info"`
+	// The status code, where 0 indicates that the notification is valid.
+	Status types.VerifyReceiptStatus `json:"status"`
+}
+
+func (n *NotificationV1) BundleID() string {
+	return n.Bid
+}
+
+// EnvironmentValue maps the PROD environment of version 1 notifications to Production.
+func (n *NotificationV1) EnvironmentValue() string {
+	if productionEnvironmentV1 == n.Environment {
+		return types.EnvProduction
+	}
+	return n.Environment
+}
+
+// ToV2
+// Map the version 1 notification type to the nearest version 2 notification type and subtype:
+//
+//	CANCEL                    -> REFUND
+//	CONSUMPTION_REQUEST       -> CONSUMPTION_REQUEST
+//	DID_CHANGE_RENEWAL_PREF   -> DID_CHANGE_RENEWAL_PREF, DOWNGRADE when auto_renew_product_id differs from the current product,
+//	                             no subtype when they match, as the customer reverted to the current subscription
+//	DID_CHANGE_RENEWAL_STATUS -> DID_CHANGE_RENEWAL_STATUS, AUTO_RENEW_ENABLED or AUTO_RENEW_DISABLED from auto_renew_status
+//	DID_FAIL_TO_RENEW         -> DID_FAIL_TO_RENEW, GRACE_PERIOD when grace_period_expires_date_ms is set, no subtype otherwise
+//	DID_RECOVER, RENEWAL      -> DID_RENEW, BILLING_RECOVERY
+//	DID_RENEW                 -> DID_RENEW
+//	INITIAL_BUY               -> SUBSCRIBED, INITIAL_BUY
+//	INTERACTIVE_RENEWAL       -> SUBSCRIBED, RESUBSCRIBE
+//	PRICE_INCREASE_CONSENT    -> PRICE_INCREASE, PENDING
+//	REFUND                    -> REFUND
+//	REVOKE                    -> REVOKE
+//
+// The current product and the grace period come from the pending_renewal_info of unified_receipt for original_transaction_id.
+// Version 1 notifications carry less detail, so the upgrade subtype is never returned.
+// @return The version 2 type and subtype, or ok false for an unknown version 1 type.
+func (n *NotificationV1) ToV2() (notificationType types.NotificationTypeV2, subtype types.Subtype, ok bool) {
+	switch n.NotificationType {
+	case types.NotificationTypeV1Cancel, types.NotificationTypeV1Refund:
+		return types.NotificationTypeV2Refund, "", true
+	case types.NotificationTypeV1ConsumptionRequest:
+		return types.NotificationTypeV2ConsumptionRequest, "", true
+	case types.NotificationTypeV1DidChangeRenewalPref:
+		if info := n.pendingRenewalInfo(); nil != info && n.AutoRenewProductId == info.ProductId {
+			return types.NotificationTypeV2DidChangeRenewalPref, "", true
+		}
+		return types.NotificationTypeV2DidChangeRenewalPref, types.SubtypeDowngrade, true
+	case types.NotificationTypeV1DidChangeRenewalStatus:
+		if n.AutoRenewStatus {
+			return types.NotificationTypeV2DidChangeRenewalStatus, types.SubtypeAutoRenewEnabled, true
+		}
+		return types.NotificationTypeV2DidChangeRenewalStatus, types.SubtypeAutoRenewDisabled, true
+	case types.NotificationTypeV1DidFailToRenew:
+		if info := n.pendingRenewalInfo(); nil != info && 0 != info.GracePeriodExpiresDateMs {
+			return types.NotificationTypeV2DidFailToRenew, types.SubtypeGracePeriod, true
+		}
+		return types.NotificationTypeV2DidFailToRenew, "", true
+	case types.NotificationTypeV1DidRecover, types.NotificationTypeV1Renewal:
+		return types.NotificationTypeV2DidRenew, types.SubtypeBillingRecovery, true
+	case types.NotificationTypeV1DidRenew:
+		return types.NotificationTypeV2DidRenew, "", true
+	case types.NotificationTypeV1InitialBuy:
+		return types.NotificationTypeV2Subscribed, types.SubtypeInitialBuy, true
+	case types.NotificationTypeV1InteractiveRenewal:
+		return types.NotificationTypeV2Subscribed, types.SubtypeResubscribe, true
+	case types.NotificationTypeV1PriceIncreaseConsent:
+		return types.NotificationTypeV2PriceIncrease, types.SubtypePending, true
+	case types.NotificationTypeV1Revoke:
+		return types.NotificationTypeV2Revoke, "", true
+	}
+	return "", "", false
+}
+
+// pendingRenewalInfo returns the pending renewal information of the subscription the notification is about.
+func (n *NotificationV1) pendingRenewalInfo() *LegacyPendingRenewalInfo {
+	if nil == n.UnifiedReceipt {
+		return nil
+	}
+	for _, info := range n.UnifiedReceipt.PendingRenewalInfo {
+		if nil != info && ("" == n.OriginalTransactionId || n.OriginalTransactionId == info.OriginalTransactionId) {
+			return info
+		}
+	}
+	return nil
+}
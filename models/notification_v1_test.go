@@ -0,0 +1,48 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+func TestNotificationV1ToV2(t *testing.T) {
+	tests := []struct {
+		name             string
+		body             string
+		notificationType types.NotificationTypeV2
+		subtype          types.Subtype
+	}{
+		{"downgrade", `{"notification_type":"DID_CHANGE_RENEWAL_PREF","auto_renew_product_id":"basic","original_transaction_id":"1",
+			"unified_receipt":{"pending_renewal_info":[{"original_transaction_id":"2","product_id":"basic"},{"original_transaction_id":"1","product_id":"premium"}]}}`,
+			types.NotificationTypeV2DidChangeRenewalPref, types.SubtypeDowngrade},
+		{"downgrade canceled", `{"notification_type":"DID_CHANGE_RENEWAL_PREF","auto_renew_product_id":"premium","original_transaction_id":"1",
+			"unified_receipt":{"pending_renewal_info":[{"original_transaction_id":"1","product_id":"premium"}]}}`,
+			types.NotificationTypeV2DidChangeRenewalPref, ""},
+		{"grace period", `{"notification_type":"DID_FAIL_TO_RENEW","original_transaction_id":"1",
+			"unified_receipt":{"pending_renewal_info":[{"original_transaction_id":"1","grace_period_expires_date_ms":"1700000000000"}]}}`,
+			types.NotificationTypeV2DidFailToRenew, types.SubtypeGracePeriod},
+		{"billing retry without grace period", `{"notification_type":"DID_FAIL_TO_RENEW","original_transaction_id":"1",
+			"unified_receipt":{"pending_renewal_info":[{"original_transaction_id":"1","is_in_billing_retry_period":"1"}]}}`,
+			types.NotificationTypeV2DidFailToRenew, ""},
+		{"auto-renew disabled", `{"notification_type":"DID_CHANGE_RENEWAL_STATUS","auto_renew_status":"false"}`,
+			types.NotificationTypeV2DidChangeRenewalStatus, types.SubtypeAutoRenewDisabled},
+		{"initial buy", `{"notification_type":"INITIAL_BUY"}`, types.NotificationTypeV2Subscribed, types.SubtypeInitialBuy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification := &NotificationV1{}
+			if err := json.Unmarshal([]byte(tt.body), notification); err != nil {
+				t.Fatal(err)
+			}
+			notificationType, subtype, ok := notification.ToV2()
+			if !ok || tt.notificationType != notificationType || tt.subtype != subtype {
+				t.Errorf("got %s %q %t, want %s %q", notificationType, subtype, ok, tt.notificationType, tt.subtype)
+			}
+		})
+	}
+	if _, _, ok := (&NotificationV1{NotificationType: "UNKNOWN"}).ToV2(); ok {
+		t.Error("expected ok false for an unknown type")
+	}
+}
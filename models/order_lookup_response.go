@@ -0,0 +1,12 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// OrderLookupResponse
+// A response that includes the order lookup status and an array of signed transactions for the in-app purchases in the order.
+type OrderLookupResponse struct {
+	// The status that indicates whether the order ID is valid.
+	Status types.OrderLookupStatus `json:"status"`
+	// An array of in-app purchase transactions that are part of order, signed by Apple, in JSON Web Signature format.
+	SignedTransactions []string `json:"signedTransactions"`
+}
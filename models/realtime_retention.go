@@ -0,0 +1,77 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// RealtimeRequestBody
+// The request body the App Store server sends to your Get Retention Message endpoint.
+type RealtimeRequestBody struct {
+	// The payload in JSON Web Signature (JWS) format, signed by the App Store.
+	SignedPayload string `json:"signedPayload"`
+}
+
+// DecodedRealtimeRequestBody
+// The decoded request body the App Store sends to your server to request a real-time retention message.
+type DecodedRealtimeRequestBody struct {
+	// The original transaction identifier of the customer’s subscription.
+	OriginalTransactionId string `json:"originalTransactionId"`
+	// The unique identifier of the app in the App Store.
+	AppAppleId int64 `json:"appAppleId"`
+	// The unique identifier of the auto-renewable subscription.
+	ProductId string `json:"productId"`
+	// The device’s locale.
+	UserLocale string `json:"userLocale"`
+	// A UUID the App Store server creates to uniquely identify each request.
+	RequestIdentifier string `json:"requestIdentifier"`
+	// The UNIX time, in milliseconds, that the App Store signed the JSON Web Signature (JWS) data.
+	SignedDate int64 `json:"signedDate"`
+	// The server environment, either sandbox or production.
+	Environment types.Environment `json:"environment"`
+}
+
+func (d *DecodedRealtimeRequestBody) EnvironmentValue() string {
+	return string(d.Environment)
+}
+
+func (d *DecodedRealtimeRequestBody) AppAppleID() int64 {
+	return d.AppAppleId
+}
+
+// RealtimeMessage
+// A message identifier you provide in a real-time response to your Get Retention Message endpoint.
+type RealtimeMessage struct {
+	// The identifier of the message to display to the customer.
+	MessageIdentifier string `json:"messageIdentifier"`
+}
+
+// AlternateProduct
+// A switch-plan message and product ID you provide in a real-time response to your Get Retention Message endpoint.
+type AlternateProduct struct {
+	// The message identifier of the text to display in the switch-plan retention message.
+	MessageIdentifier string `json:"messageIdentifier"`
+	// The product identifier of the subscription the retention message suggests for your customer to switch to.
+	ProductId string `json:"productId"`
+}
+
+// PromotionalOffer
+// A promotional offer and message you provide in a real-time response to your Get Retention Message endpoint.
+type PromotionalOffer struct {
+	// The identifier of the message to display to the customer, along with the promotional offer.
+	MessageIdentifier string `json:"messageIdentifier"`
+	// The promotional offer signature in JWS format.
+	PromotionalOfferSignatureV2 string `json:"promotionalOfferSignatureV2,omitempty"`
+	// The identifier of the promotional offer to sign. When it is set and PromotionalOfferSignatureV2 is empty,
+	// RealtimeRetentionHandler signs the offer for the requested product and subscription. It isn't sent to Apple.
+	OfferIdentifier string `json:"-"`
+}
+
+// RealtimeResponseBody
+// A response you provide to choose, in real time, a retention message the system displays to the customer.
+// Provide at most one of Message, AlternateProduct and PromotionalOffer.
+type RealtimeResponseBody struct {
+	// A retention message that’s text-based and can include an optional image.
+	Message *RealtimeMessage `json:"message,omitempty"`
+	// A retention message with a switch-plan option.
+	AlternateProduct *AlternateProduct `json:"alternateProduct,omitempty"`
+	// A retention message that includes a promotional offer.
+	PromotionalOffer *PromotionalOffer `json:"promotionalOffer,omitempty"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// RefundHistoryResponse
+// A response that contains an array of signed JSON Web Signature (JWS) refunded transactions, and paging information.
+type RefundHistoryResponse struct {
+	// A list of up to 20 JWS transactions, or an empty array if the customer hasn't received any refunds in your app. The transactions are sorted in ascending order by revocationDate.
+	SignedTransactions []string `json:"signedTransactions"`
+	// A token you use in a query to request the next set of transactions for the customer.
+	Revision string `json:"revision"`
+	// A Boolean value indicating whether the App Store has more transaction data.
+	HasMore bool `json:"hasMore"`
+}
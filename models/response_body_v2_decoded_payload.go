@@ -0,0 +1,106 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// ResponseBodyV2
+// The response body the App Store sends in a version 2 server notification.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/responsebodyv2">ResponseBodyV2</a>
+type ResponseBodyV2 struct {
+	// A cryptographically signed payload, in JSON Web Signature (JWS) format, containing the response body for a version 2 notification.
+	SignedPayload string `json:"signedPayload"`
+}
+
+// ResponseBodyV2DecodedPayload
+// A decoded payload containing the version 2 notification data.
+type ResponseBodyV2DecodedPayload struct {
+	// The in-app purchase event for which the App Store sends this version 2 notification.
+	NotificationType types.NotificationTypeV2 `json:"notificationType"`
+	// Additional information that identifies the notification event. The subtype field is present only for specific version 2 notifications.
+	Subtype types.Subtype `json:"subtype,omitempty"`
+	// A unique identifier for the notification.
+	NotificationUUID string `json:"notificationUUID"`
+	// A string that indicates the notification’s App Store Server Notifications version number.
+	Version string `json:"version"`
+	// The UNIX time, in milliseconds, that the App Store signed the JSON Web Signature data.
+	SignedDate int64 `json:"signedDate"`
+	// The object that contains the app metadata and signed renewal and transaction information.
+	// The data, summary, and externalPurchaseToken fields are mutually exclusive.
+	Data *NotificationData `json:"data,omitempty"`
+	// The summary data that appears when the App Store server completes your request to extend a subscription renewal date for eligible subscribers.
+	Summary *NotificationSummary `json:"summary,omitempty"`
+	// This field appears when the notificationType is EXTERNAL_PURCHASE_TOKEN.
+	ExternalPurchaseToken *ExternalPurchaseToken `json:"externalPurchaseToken,omitempty"`
+}
+
+// NotificationData
+// The app metadata and the signed renewal and transaction information of a notification.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/data">data</a>
+type NotificationData struct {
+	// The unique identifier of an app in the App Store.
+	AppAppleId int64 `json:"appAppleId"`
+	// The bundle identifier of an app.
+	BundleId string `json:"bundleId"`
+	// The version of the build that identifies an iteration of the bundle.
+	BundleVersion string `json:"bundleVersion"`
+	// The server environment that the notification applies to, either sandbox or production.
+	Environment types.Environment `json:"environment"`
+	// Subscription renewal information, signed by the App Store, in JSON Web Signature (JWS) format.
+	SignedRenewalInfo string `json:"signedRenewalInfo,omitempty"`
+	// Transaction information, signed by the App Store, in JSON Web Signature (JWS) format.
+	SignedTransactionInfo string `json:"signedTransactionInfo,omitempty"`
+	// The status of an auto-renewable subscription as of the signedDate in the responseBodyV2DecodedPayload.
+	Status types.Status `json:"status,omitempty"`
+	// The reason the customer requested the refund. This field appears only for CONSUMPTION_REQUEST notifications.
+	ConsumptionRequestReason types.ConsumptionRequestReason `json:"consumptionRequestReason,omitempty"`
+
+	// The verified and decoded signedTransactionInfo, set by SignedDataVerifier.VerifyAndDecodeNotification.
+	TransactionInfo *JWSTransactionDecodedPayload `json:"-"`
+	// The verified and decoded signedRenewalInfo, set by SignedDataVerifier.VerifyAndDecodeNotification.
+	RenewalInfo *JWSRenewalInfoDecodedPayload `json:"-"`
+}
+
+func (d *NotificationData) BundleID() string {
+	return d.BundleId
+}
+
+func (d *NotificationData) EnvironmentValue() string {
+	return string(d.Environment)
+}
+
+func (d *NotificationData) AppAppleID() int64 {
+	return d.AppAppleId
+}
+
+// NotificationSummary
+// The payload data for a subscription-renewal-date extension notification.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/summary">summary</a>
+type NotificationSummary struct {
+	// The UUID that represents a specific request to extend a subscription renewal date.
+	RequestIdentifier string `json:"requestIdentifier"`
+	// The server environment that the notification applies to, either sandbox or production.
+	Environment types.Environment `json:"environment"`
+	// The unique identifier of an app in the App Store.
+	AppAppleId int64 `json:"appAppleId"`
+	// The bundle identifier of an app.
+	BundleId string `json:"bundleId"`
+	// The unique identifier for the product, that you create in App Store Connect.
+	ProductId string `json:"productId"`
+	// A list of storefront country codes you provide to limit the storefronts for a subscription-renewal-date extension.
+	StorefrontCountryCodes []string `json:"storefrontCountryCodes,omitempty"`
+	// The final count of subscriptions that fail to receive a subscription-renewal-date extension.
+	FailedCount int64 `json:"failedCount"`
+	// The final count of subscriptions that successfully receive a subscription-renewal-date extension.
+	SucceededCount int64 `json:"succeededCount"`
+}
+
+func (s *NotificationSummary) BundleID() string {
+	return s.BundleId
+}
+
+func (s *NotificationSummary) EnvironmentValue() string {
+	return string(s.Environment)
+}
+
+func (s *NotificationSummary) AppAppleID() int64 {
+	return s.AppAppleId
+}
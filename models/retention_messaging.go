@@ -0,0 +1,62 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// UploadMessageImage
+// The definition of an image with its alternative text.
+type UploadMessageImage struct {
+	// The unique identifier of an image.
+	ImageIdentifier string `json:"imageIdentifier"`
+	// The alternative text you provide for the corresponding image.
+	AltText string `json:"altText"`
+}
+
+// UploadMessageRequestBody
+// The request body for uploading a message, which includes the message text and an optional image reference.
+type UploadMessageRequestBody struct {
+	// The header text of the retention message that the system displays to customers.
+	Header string `json:"header"`
+	// The body text of the retention message that the system displays to customers.
+	Body string `json:"body"`
+	// The optional image identifier and its alternative text to appear as part of a text-based message with an image.
+	Image *UploadMessageImage `json:"image,omitempty"`
+}
+
+// GetImageListResponseItem
+// An image identifier and state information for an image.
+type GetImageListResponseItem struct {
+	// The identifier of the image.
+	ImageIdentifier string `json:"imageIdentifier"`
+	// The current state of the image.
+	ImageState types.ImageState `json:"imageState"`
+}
+
+// GetImageListResponse
+// A response that contains status information for all images.
+type GetImageListResponse struct {
+	// An array of all image identifiers and their image state.
+	ImageIdentifiers []*GetImageListResponseItem `json:"imageIdentifiers"`
+}
+
+// GetMessageListResponseItem
+// A message identifier and status information for a message.
+type GetMessageListResponseItem struct {
+	// The identifier of the message.
+	MessageIdentifier string `json:"messageIdentifier"`
+	// The current state of the message.
+	MessageState types.MessageState `json:"messageState"`
+}
+
+// GetMessageListResponse
+// A response that contains status information for all messages.
+type GetMessageListResponse struct {
+	// An array of all message identifiers and their message state.
+	MessageIdentifiers []*GetMessageListResponseItem `json:"messageIdentifiers"`
+}
+
+// DefaultConfigurationRequest
+// The request body that contains the default configuration information.
+type DefaultConfigurationRequest struct {
+	// The message identifier of the message to configure as a default message.
+	MessageIdentifier string `json:"messageIdentifier"`
+}
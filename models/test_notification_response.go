@@ -0,0 +1,17 @@
+package models
+
+// SendTestNotificationResponse
+// A response that contains the test notification token.
+type SendTestNotificationResponse struct {
+	// A unique identifier for a notification test that the App Store server sends to your server.
+	TestNotificationToken string `json:"testNotificationToken"`
+}
+
+// CheckTestNotificationResponse
+// A response that contains the contents of the test notification sent by the App Store server and the result from your server.
+type CheckTestNotificationResponse struct {
+	// A cryptographically signed payload, in JSON Web Signature (JWS) format, containing the response body for a version 2 notification.
+	SignedPayload string `json:"signedPayload"`
+	// An array of information the App Store server records for its attempts to send the TEST notification to your server. The array may contain a maximum of six sendAttemptItem objects.
+	SendAttempts []*SendAttemptItem `json:"sendAttempts"`
+}
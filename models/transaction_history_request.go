@@ -0,0 +1,25 @@
+package models
+
+import "github.com/meetleev/go-apple-store-server/types"
+
+// TransactionHistoryRequest
+// The query parameters that filter and sort the results of a transaction history request.
+type TransactionHistoryRequest struct {
+	// An optional start date of the timespan for the transaction history records you’re requesting, in UNIX time, in milliseconds.
+	StartDate int64
+	// An optional end date of the timespan for the transaction history records you’re requesting, in UNIX time, in milliseconds.
+	EndDate int64
+	// An optional filter that indicates the product identifier to include in the transaction history.
+	ProductIds []string
+	// An optional filter that indicates the product type to include in the transaction history.
+	ProductTypes []types.ProductType
+	// An optional sort order for the transaction history records. The default is ascending.
+	Sort types.Order
+	// An optional filter that indicates the subscription group identifier to include in the transaction history.
+	SubscriptionGroupIdentifiers []string
+	// An optional filter that limits the transaction history by the in-app ownership type.
+	InAppOwnershipType types.InAppOwnershipType
+	// An optional Boolean value that indicates whether the response includes only revoked transactions when the value is true,
+	// or contains only nonrevoked transactions when the value is false. By default, the request doesn't include this parameter.
+	Revoked *bool
+}
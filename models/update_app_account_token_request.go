@@ -0,0 +1,8 @@
+package models
+
+// UpdateAppAccountTokenRequest
+// The request body that contains an app account token value.
+type UpdateAppAccountTokenRequest struct {
+	// The UUID that an app optionally generates to map a customer’s in-app purchase with its resulting App Store transaction.
+	AppAccountToken string `json:"appAccountToken"`
+}
@@ -0,0 +1,240 @@
+package models
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// LegacyInt64
+// A number the verifyReceipt endpoint encodes as a JSON string, such as a date in milliseconds. An empty string decodes as zero.
+type LegacyInt64 int64
+
+func (n *LegacyInt64) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if 0 == len(data) || "null" == string(data) {
+		*n = 0
+		return nil
+	}
+	v, err := strconv.ParseInt(string(data), 10, 64)
+	if nil != err {
+		return err
+	}
+	*n = LegacyInt64(v)
+	return nil
+}
+
+// LegacyBool
+// A Boolean the verifyReceipt endpoint encodes as the JSON string "true" or "false".
+type LegacyBool bool
+
+func (b *LegacyBool) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	*b = LegacyBool("true" == string(data) || "1" == string(data))
+	return nil
+}
+
+// VerifyReceiptRequest
+// The JSON contents you submit with the request to the verifyReceipt endpoint.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/requestbody">requestBody</a>
+type VerifyReceiptRequest struct {
+	// The Base64-encoded receipt data.
+	ReceiptData string `json:"receipt-data"`
+	// Your app’s shared secret, which is a hexadecimal string. Required for receipts that contain auto-renewable subscriptions.
+	Password string `json:"password,omitempty"`
+	// Set this value to true for the response to include only the latest renewal transaction for any subscriptions.
+	ExcludeOldTransactions bool `json:"exclude-old-transactions,omitempty"`
+}
+
+// VerifyReceiptResponse
+// The JSON data returned in the response from the verifyReceipt endpoint.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/responsebody">responseBody</a>
+type VerifyReceiptResponse struct {
+	// The environment for which the receipt was generated.
+	Environment types.Environment `json:"environment"`
+	// An indicator that an error occurred during the request.
+	IsRetryable bool `json:"is-retryable"`
+	// The latest Base64 encoded app receipt. Only returned for receipts that contain auto-renewable subscriptions.
+	LatestReceipt string `json:"latest_receipt"`
+	// An array that contains all in-app purchase transactions. Only returned for receipts that contain auto-renewable subscriptions.
+	LatestReceiptInfo []*LegacyInAppTransaction `json:"latest_receipt_info"`
+	// An array where each element contains the pending renewal information for each auto-renewable subscription identified by the product_id.
+	PendingRenewalInfo []*LegacyPendingRenewalInfo `json:"pending_renewal_info"`
+	// The decoded version of the encoded receipt data sent with the request to the App Store.
+	Receipt *LegacyReceipt `json:"receipt"`
+	// Either 0 if the receipt is valid, or a status code if there is an error.
+	Status types.VerifyReceiptStatus `json:"status"`
+}
+
+// LegacyReceipt
+// The decoded version of the encoded receipt data sent with the request to the App Store.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/responsebody/receipt">receipt</a>
+type LegacyReceipt struct {
+	// See app_item_id.
+	AdamId int64 `json:"adam_id"`
+	// Generated by App Store Connect and used by the App Store to uniquely identify the app purchased. Zero in the sandbox.
+	AppItemId int64 `json:"app_item_id"`
+	// The app’s version number.
+	ApplicationVersion string `json:"application_version"`
+	// The bundle identifier for the app to which the receipt belongs.
+	BundleId string `json:"bundle_id"`
+	// A unique identifier for the app download transaction.
+	DownloadId int64 `json:"download_id"`
+	// The time the receipt expires for apps purchased through the Volume Purchase Program, in UNIX epoch time format, in milliseconds.
+	ExpirationDateMs LegacyInt64 `json:"expiration_date_ms"`
+	// An array that contains the in-app purchase receipt fields for all in-app purchase transactions.
+	InApp []*LegacyInAppTransaction `json:"in_app"`
+	// The version of the app that the user originally purchased.
+	OriginalApplicationVersion string `json:"original_application_version"`
+	// The time of the original app purchase, in UNIX epoch time format, in milliseconds.
+	OriginalPurchaseDateMs LegacyInt64 `json:"original_purchase_date_ms"`
+	// The time the user ordered the app available for pre-order, in UNIX epoch time format, in milliseconds.
+	PreorderDateMs LegacyInt64 `json:"preorder_date_ms"`
+	// The time the App Store generated the receipt, in UNIX epoch time format, in milliseconds.
+	ReceiptCreationDateMs LegacyInt64 `json:"receipt_creation_date_ms"`
+	// The type of receipt generated: Production, ProductionVPP, ProductionSandbox or ProductionVPPSandbox.
+	ReceiptType string `json:"receipt_type"`
+	// The time the request to the verifyReceipt endpoint was processed, in UNIX epoch time format, in milliseconds.
+	RequestDateMs LegacyInt64 `json:"request_date_ms"`
+	// An arbitrary number that identifies a revision of your app. In the sandbox, this key's value is 0.
+	VersionExternalIdentifier int64 `json:"version_external_identifier"`
+}
+
+// LegacyInAppTransaction
+// The in-app purchase receipt fields of a transaction, as found in latest_receipt_info and in_app.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/responsebody/latest_receipt_info">latest_receipt_info</a>
+type LegacyInAppTransaction struct {
+	// The appAccountToken associated with this transaction.
+	AppAccountToken string `json:"app_account_token"`
+	// The time the App Store refunded a transaction or revoked it from family sharing, in UNIX epoch time format, in milliseconds.
+	CancellationDateMs LegacyInt64 `json:"cancellation_date_ms"`
+	// The reason for a refunded or revoked transaction: 1 for an issue within your app, 0 for another reason.
+	CancellationReason LegacyInt64 `json:"cancellation_reason"`
+	// The time a subscription expires or when it will renew, in UNIX epoch time format, in milliseconds.
+	ExpiresDateMs LegacyInt64 `json:"expires_date_ms"`
+	// A value that indicates whether the user is the purchaser of the product or is a family member with access to the product through Family Sharing.
+	InAppOwnershipType types.InAppOwnershipType `json:"in_app_ownership_type"`
+	// An indicator of whether an auto-renewable subscription is in the introductory price period.
+	IsInIntroOfferPeriod LegacyBool `json:"is_in_intro_offer_period"`
+	// An indicator of whether a subscription is in the free trial period.
+	IsTrialPeriod LegacyBool `json:"is_trial_period"`
+	// An indicator that a subscription has been canceled due to an upgrade. This field is only present for upgrade transactions.
+	IsUpgraded LegacyBool `json:"is_upgraded"`
+	// The reference name of a subscription offer that you configured in App Store Connect.
+	OfferCodeRefName string `json:"offer_code_ref_name"`
+	// The time of the original in-app purchase, in UNIX epoch time format, in milliseconds.
+	OriginalPurchaseDateMs LegacyInt64 `json:"original_purchase_date_ms"`
+	// The transaction identifier of the original purchase.
+	OriginalTransactionId string `json:"original_transaction_id"`
+	// The unique identifier of the product purchased.
+	ProductId string `json:"product_id"`
+	// The identifier of the subscription offer redeemed by the user.
+	PromotionalOfferId string `json:"promotional_offer_id"`
+	// The time the App Store charged the user’s account for a purchase or renewal, in UNIX epoch time format, in milliseconds.
+	PurchaseDateMs LegacyInt64 `json:"purchase_date_ms"`
+	// The number of consumable products purchased.
+	Quantity LegacyInt64 `json:"quantity"`
+	// The identifier of the subscription group to which the subscription belongs.
+	SubscriptionGroupIdentifier string `json:"subscription_group_identifier"`
+	// A unique identifier for purchase events across devices, including subscription-renewal events.
+	WebOrderLineItemId string `json:"web_order_line_item_id"`
+	// A unique identifier for a transaction such as a purchase, restore, or renewal.
+	TransactionId string `json:"transaction_id"`
+}
+
+// LegacyPendingRenewalInfo
+// The pending renewal information of an auto-renewable subscription.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/responsebody/pending_renewal_info">pending_renewal_info</a>
+type LegacyPendingRenewalInfo struct {
+	// The product identifier of the product that renews at the next billing period.
+	AutoRenewProductId string `json:"auto_renew_product_id"`
+	// The renewal status for the auto-renewable subscription.
+	AutoRenewStatus LegacyInt64 `json:"auto_renew_status"`
+	// The reason a subscription expired.
+	ExpirationIntent LegacyInt64 `json:"expiration_intent"`
+	// The time at which the grace period for subscription renewals expires, in UNIX epoch time format, in milliseconds.
+	GracePeriodExpiresDateMs LegacyInt64 `json:"grace_period_expires_date_ms"`
+	// A flag that indicates Apple is attempting to renew an expired subscription automatically.
+	IsInBillingRetryPeriod LegacyInt64 `json:"is_in_billing_retry_period"`
+	// The reference name of a subscription offer that you configured in App Store Connect.
+	OfferCodeRefName string `json:"offer_code_ref_name"`
+	// The transaction identifier of the original purchase.
+	OriginalTransactionId string `json:"original_transaction_id"`
+	// The price consent status for an auto-renewable subscription price increase that requires customer consent.
+	PriceConsentStatus LegacyInt64 `json:"price_consent_status"`
+	// The unique identifier of the product purchased.
+	ProductId string `json:"product_id"`
+	// The identifier of the promotional offer for an auto-renewable subscription that the user redeemed.
+	PromotionalOfferId string `json:"promotional_offer_id"`
+	// The status that indicates if an auto-renewable subscription is subject to a price increase.
+	PriceIncreaseStatus LegacyInt64 `json:"price_increase_status"`
+}
+
+// ToJWSTransactionDecodedPayload
+// Map the legacy fields of the transaction to the fields of a JWSTransactionDecodedPayload,
+// so verifyReceipt and App Store Server API transactions can be handled the same way.
+// The fields with no legacy equivalent, such as price, storefront and signedDate, are left empty.
+// The legacy receipt doesn't record the product type: PurchaseType is set to Auto-Renewable Subscription for transactions
+// with an expiration date or a subscription group, and left empty for consumables, non-consumables and non-renewing subscriptions.
+func (t *LegacyInAppTransaction) ToJWSTransactionDecodedPayload(bundleId string, environment types.Environment) *JWSTransactionDecodedPayload {
+	payload := &JWSTransactionDecodedPayload{
+		AppAccountToken:             t.AppAccountToken,
+		BundleId:                    bundleId,
+		Environment:                 environment,
+		ExpiresDate:                 int64(t.ExpiresDateMs),
+		InAppOwnershipType:          t.InAppOwnershipType,
+		IsUpgraded:                  bool(t.IsUpgraded),
+		OriginalPurchaseDate:        int64(t.OriginalPurchaseDateMs),
+		OriginalTransactionId:       t.OriginalTransactionId,
+		ProductId:                   t.ProductId,
+		PurchaseDate:                int64(t.PurchaseDateMs),
+		Quantity:                    int32(t.Quantity),
+		RevocationDate:              int64(t.CancellationDateMs),
+		SubscriptionGroupIdentifier: t.SubscriptionGroupIdentifier,
+		TransactionId:               t.TransactionId,
+		WebOrderLineItemId:          t.WebOrderLineItemId,
+	}
+	if 0 != payload.RevocationDate {
+		payload.RevocationReason = int32(t.CancellationReason)
+	}
+	if "" == payload.InAppOwnershipType {
+		payload.InAppOwnershipType = types.InAppOwnershipTypePurchased
+	}
+	if "" != t.SubscriptionGroupIdentifier || 0 != payload.ExpiresDate {
+		payload.PurchaseType = types.PurchaseTypeAutoRenewableSubscription
+	}
+	switch {
+	case "" != t.PromotionalOfferId:
+		payload.OfferType = types.OfferTypePromotionalOffer
+		payload.OfferIdentifier = t.PromotionalOfferId
+	case "" != t.OfferCodeRefName:
+		payload.OfferType = types.OfferTypeSubscriptionOfferCode
+		payload.OfferIdentifier = t.OfferCodeRefName
+	case bool(t.IsTrialPeriod || t.IsInIntroOfferPeriod):
+		payload.OfferType = types.OfferTypeIntroductoryOffer
+	}
+	if t.IsTrialPeriod {
+		payload.OfferDiscountType = types.OfferDiscountTypeFreeTrial
+	}
+	return payload
+}
+
+// Transactions
+// Return the transactions of the response as JWSTransactionDecodedPayload, from latest_receipt_info when present,
+// otherwise from the in_app array of the receipt. The bundle id is taken from the receipt, and is empty without it.
+func (r *VerifyReceiptResponse) Transactions() []*JWSTransactionDecodedPayload {
+	var bundleId string
+	transactions := r.LatestReceiptInfo
+	if nil != r.Receipt {
+		bundleId = r.Receipt.BundleId
+		if 0 == len(transactions) {
+			transactions = r.Receipt.InApp
+		}
+	}
+	payloads := make([]*JWSTransactionDecodedPayload, 0, len(transactions))
+	for _, transaction := range transactions {
+		payloads = append(payloads, transaction.ToJWSTransactionDecodedPayload(bundleId, r.Environment))
+	}
+	return payloads
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVerifyReceiptResponseTransactions(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		transactionIds []string
+		bundleId       string
+	}{
+		{"latest receipt info", `{"environment":"Sandbox","receipt":{"bundle_id":"com.example","in_app":[{"transaction_id":"1"}]},"latest_receipt_info":[{"transaction_id":"2"},{"transaction_id":"3"}]}`,
+			[]string{"2", "3"}, "com.example"},
+		{"in_app without latest receipt info", `{"environment":"Sandbox","receipt":{"bundle_id":"com.example","in_app":[{"transaction_id":"1"}]}}`,
+			[]string{"1"}, "com.example"},
+		{"latest receipt info without receipt", `{"environment":"Sandbox","latest_receipt_info":[{"transaction_id":"2"}]}`,
+			[]string{"2"}, ""},
+		{"no transactions", `{"environment":"Sandbox","status":21002}`, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &VerifyReceiptResponse{}
+			if err := json.Unmarshal([]byte(tt.body), response); err != nil {
+				t.Fatal(err)
+			}
+			transactions := response.Transactions()
+			if len(tt.transactionIds) != len(transactions) {
+				t.Fatalf("got %d transactions, want %d", len(transactions), len(tt.transactionIds))
+			}
+			for i, transaction := range transactions {
+				if tt.transactionIds[i] != transaction.TransactionId || tt.bundleId != transaction.BundleId || "Sandbox" != transaction.Environment {
+					t.Errorf("unexpected transaction %+v", transaction)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package apple_store_server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	"github.com/meetleev/go-apple-store-server/verifier"
+	logger "github.com/sirupsen/logrus"
+)
+
+const maxNotificationBodySize = 1 << 20
+
+// NotificationFunc processes a verified notification. Returning an error answers with a 500 status,
+// so the App Store sends the notification again later.
+type NotificationFunc func(ctx context.Context, notification *models.ResponseBodyV2DecodedPayload) error
+
+// NotificationHandler
+// An http.Handler for the App Store Server Notifications V2 endpoint of your server.
+// It verifies the signedPayload with the verifier of its environment, decodes the notification with its
+// signed transaction and renewal info and passes it to the callback. It answers with 200 when the callback succeeds,
+// 4xx for malformed or forged requests and 500 when the callback fails, which makes the App Store retry.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/responding-to-app-store-server-notifications">Responding to App Store Server Notifications</a>
+type NotificationHandler struct {
+	productionVerifier *verifier.SignedDataVerifier
+	sandboxVerifier    *verifier.SignedDataVerifier
+	callback           NotificationFunc
+}
+
+// NewNotificationHandler creates a handler that verifies production and sandbox notifications with their own verifier,
+// each configured for your bundle ID and its environment. A nil verifier rejects the notifications of its environment.
+// Notifications of every environment other than Sandbox, including Xcode and LocalTesting, are verified by productionVerifier.
+func NewNotificationHandler(productionVerifier, sandboxVerifier *verifier.SignedDataVerifier, callback NotificationFunc) (*NotificationHandler, error) {
+	if nil == callback {
+		return nil, errors.New("notification callback is required")
+	}
+	if nil == productionVerifier && nil == sandboxVerifier {
+		return nil, errors.New("a production or sandbox verifier is required")
+	}
+	return &NotificationHandler{productionVerifier: productionVerifier, sandboxVerifier: sandboxVerifier, callback: callback}, nil
+}
+
+func (h *NotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if http.MethodPost != r.Method {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxNotificationBodySize))
+	if err != nil {
+		logger.Errorf("read notification request failed [%v]", err)
+		// Other read failures than an oversized or truncated body are transient, the 5xx answer makes the App Store retry.
+		w.WriteHeader(readBodyErrorStatus(err))
+		return
+	}
+	requestBody := &models.ResponseBodyV2{}
+	if err = json.Unmarshal(body, requestBody); err != nil || "" == requestBody.SignedPayload {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	environment, err := unverifiedNotificationEnvironment(requestBody.SignedPayload)
+	if err != nil {
+		logger.Errorf("parse notification failed [%v]", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	signedDataVerifier := h.productionVerifier
+	if types.EnvSandbox == environment {
+		signedDataVerifier = h.sandboxVerifier
+	}
+	if nil == signedDataVerifier {
+		logger.Errorf("no verifier for notification environment %s", environment)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	notification, err := signedDataVerifier.VerifyAndDecodeNotification(requestBody.SignedPayload)
+	if err != nil {
+		logger.Errorf("verify notification failed [%v]", err)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	if err = h.callback(r.Context(), notification); err != nil {
+		logger.Errorf("notification callback failed [%v], notificationUUID:%s", err, notification.NotificationUUID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// unverifiedNotificationEnvironment reads the environment of a signedPayload, before its signature is verified,
+// to choose the verifier. The verifier checks the environment again.
+func unverifiedNotificationEnvironment(signedPayload string) (types.Environment, error) {
+	parts := strings.Split(signedPayload, ".")
+	if 3 != len(parts) {
+		return "", errors.New("signedPayload contains an invalid number of segments")
+	}
+	claims, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", err
+	}
+	payload := &models.ResponseBodyV2DecodedPayload{}
+	if err = json.Unmarshal(claims, payload); err != nil {
+		return "", err
+	}
+	switch {
+	case nil != payload.Data:
+		return payload.Data.Environment, nil
+	case nil != payload.Summary:
+		return payload.Summary.Environment, nil
+	case nil != payload.ExternalPurchaseToken:
+		return payload.ExternalPurchaseToken.EnvironmentValue(), nil
+	}
+	return "", errors.New("notification has no environment")
+}
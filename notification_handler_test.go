@@ -0,0 +1,190 @@
+package apple_store_server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	"github.com/meetleev/go-apple-store-server/verifier"
+)
+
+type testSigningChain struct {
+	root     *x509.Certificate
+	x5c      []string
+	leafKey  *ecdsa.PrivateKey
+	otherKey *ecdsa.PrivateKey
+}
+
+func newTestCertificate(t *testing.T, name string, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if nil == parent {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certificate
+}
+
+// newTestSigningChain creates a root, an intermediate and a leaf certificate to sign test notifications with.
+func newTestSigningChain(t *testing.T) *testSigningChain {
+	t.Helper()
+	rootKey, intermediateKey, leafKey := newTestSigningKey(t), newTestSigningKey(t), newTestSigningKey(t)
+	root := newTestCertificate(t, "root", rootKey, nil, nil, true)
+	intermediate := newTestCertificate(t, "intermediate", intermediateKey, root, rootKey, true)
+	leaf := newTestCertificate(t, "leaf", leafKey, intermediate, intermediateKey, false)
+	return &testSigningChain{
+		root:     root,
+		x5c:      []string{base64.StdEncoding.EncodeToString(leaf.Raw), base64.StdEncoding.EncodeToString(intermediate.Raw)},
+		leafKey:  leafKey,
+		otherKey: newTestSigningKey(t),
+	}
+}
+
+func (c *testSigningChain) sign(t *testing.T, key *ecdsa.PrivateKey, payload interface{}) string {
+	t.Helper()
+	claims, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header, err := json.Marshal(map[string]interface{}{"alg": "ES256", "x5c": c.x5c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingString := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	signature, err := jwt.SigningMethodES256.Sign(signingString, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingString + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (c *testSigningChain) verifier(t *testing.T, environment types.Environment) *verifier.SignedDataVerifier {
+	t.Helper()
+	appAppleId := int64(1234)
+	v := verifier.NewSignedDataVerifier([]*x509.Certificate{c.root})
+	if err := v.ConfigureAppStore(verifier.AppStoreVerificationConfig{Environment: environment, BundleId: "com.example", AppAppleId: &appAppleId}); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func testNotificationBody(t *testing.T, signedPayload string) string {
+	t.Helper()
+	body, err := json.Marshal(&models.ResponseBodyV2{SignedPayload: signedPayload})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+type failingReader struct {
+	err error
+}
+
+func (r failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func TestNotificationHandler(t *testing.T) {
+	chain := newTestSigningChain(t)
+	notification := func(environment types.Environment) *models.ResponseBodyV2DecodedPayload {
+		return &models.ResponseBodyV2DecodedPayload{
+			NotificationType: types.NotificationTypeV2Test,
+			NotificationUUID: "002e14d5-51f5-4503-b5a8-c3a1af68eb20",
+			Data:             &models.NotificationData{AppAppleId: 1234, BundleId: "com.example", Environment: environment},
+		}
+	}
+	sandbox := testNotificationBody(t, chain.sign(t, chain.leafKey, notification(types.EnvSandbox)))
+	callbackErr := errors.New("callback failed")
+
+	tests := []struct {
+		name       string
+		method     string
+		body       io.Reader
+		sandbox    bool
+		production bool
+		callback   error
+		status     int
+	}{
+		{"method not allowed", http.MethodGet, strings.NewReader(sandbox), true, true, nil, http.StatusMethodNotAllowed},
+		{"oversized body", http.MethodPost, strings.NewReader(strings.Repeat(" ", maxNotificationBodySize+1)), true, true, nil, http.StatusRequestEntityTooLarge},
+		{"truncated body", http.MethodPost, failingReader{err: io.ErrUnexpectedEOF}, true, true, nil, http.StatusBadRequest},
+		{"read failure", http.MethodPost, failingReader{err: errors.New("connection reset")}, true, true, nil, http.StatusInternalServerError},
+		{"malformed json", http.MethodPost, strings.NewReader("{"), true, true, nil, http.StatusBadRequest},
+		{"no environment", http.MethodPost, strings.NewReader(testNotificationBody(t, chain.sign(t, chain.leafKey, &models.ResponseBodyV2DecodedPayload{NotificationType: types.NotificationTypeV2Test}))),
+			true, true, nil, http.StatusBadRequest},
+		{"no verifier for the environment", http.MethodPost, strings.NewReader(testNotificationBody(t, chain.sign(t, chain.leafKey, notification(types.EnvProduction)))),
+			true, false, nil, http.StatusBadRequest},
+		{"forged signature", http.MethodPost, strings.NewReader(testNotificationBody(t, chain.sign(t, chain.otherKey, notification(types.EnvSandbox)))),
+			true, true, nil, http.StatusForbidden},
+		{"callback error", http.MethodPost, strings.NewReader(sandbox), true, true, callbackErr, http.StatusInternalServerError},
+		{"success", http.MethodPost, strings.NewReader(sandbox), true, false, nil, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sandboxVerifier, productionVerifier *verifier.SignedDataVerifier
+			if tt.sandbox {
+				sandboxVerifier = chain.verifier(t, types.EnvSandbox)
+			}
+			if tt.production {
+				productionVerifier = chain.verifier(t, types.EnvProduction)
+			}
+			var received *models.ResponseBodyV2DecodedPayload
+			handler, err := NewNotificationHandler(productionVerifier, sandboxVerifier, func(ctx context.Context, notification *models.ResponseBodyV2DecodedPayload) error {
+				received = notification
+				return tt.callback
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, "/notifications", tt.body))
+			if tt.status != recorder.Code {
+				t.Errorf("got status %d, want %d", recorder.Code, tt.status)
+			}
+			if http.StatusOK == tt.status && (nil == received || "002e14d5-51f5-4503-b5a8-c3a1af68eb20" != received.NotificationUUID) {
+				t.Errorf("unexpected notification %+v", received)
+			}
+		})
+	}
+}
+
+func TestNewNotificationHandlerRequiresCallbackAndVerifier(t *testing.T) {
+	v := newTestSigningChain(t).verifier(t, types.EnvSandbox)
+	if _, err := NewNotificationHandler(nil, v, nil); err == nil {
+		t.Error("expected an error without a callback")
+	}
+	if _, err := NewNotificationHandler(nil, nil, func(context.Context, *models.ResponseBodyV2DecodedPayload) error { return nil }); err == nil {
+		t.Error("expected an error without a verifier")
+	}
+}
@@ -0,0 +1,64 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+)
+
+// promotionalOfferPayloadSeparator is the invisible separator (U+2063) between the fields of the signed payload.
+const promotionalOfferPayloadSeparator = "\u2063"
+
+// PromotionalOfferSignatureCreator
+// Creates the signatures StoreKit 1 needs to apply promotional offers (SKPaymentDiscount).
+// @see <a href="https://developer.apple.com/documentation/storekit/in-app_purchase/original_api_for_in-app_purchase/subscriptions_and_offers/generating_a_signature_for_promotional_offers">Generating a signature for promotional offers</a>
+type PromotionalOfferSignatureCreator struct {
+	// Your private key downloaded from App Store Connect, as loaded by PrivateKeyFromFile or PrivateKeyFromBytes
+	privateKey *ecdsa.PrivateKey
+	// Your private key ID from App Store Connect
+	keyId string
+	// Your app’s bundle ID (Ex: “com.example.testbundleid”)
+	bundleId string
+}
+
+func NewPromotionalOfferSignatureCreator(privateKey *ecdsa.PrivateKey, keyId, bundleId string) *PromotionalOfferSignatureCreator {
+	return &PromotionalOfferSignatureCreator{privateKey: privateKey, keyId: keyId, bundleId: bundleId}
+}
+
+// CreateSignature
+// Return the Base64 encoded DER signature of a promotional offer.
+// @param productIdentifier The subscription product identifier.
+// @param subscriptionOfferID The subscription discount identifier.
+// @param applicationUsername An optional string value that you define; may be an empty string.
+// @param nonce A one-time UUID value that your server generates. Generate a new nonce for every signature.
+// @param timestamp A timestamp your server generates in UNIX time format, in milliseconds. The timestamp keeps the offer active for 24 hours.
+// @return The Base64 encoded signature.
+func (c *PromotionalOfferSignatureCreator) CreateSignature(productIdentifier, subscriptionOfferID, applicationUsername, nonce string, timestamp int64) (string, error) {
+	if c.privateKey == nil {
+		return "", errors.New("PrivateKey not given")
+	}
+	if !internal.IsUUID(nonce) {
+		return "", errors.New("nonce must be a UUID")
+	}
+	payload := strings.Join([]string{
+		c.bundleId,
+		c.keyId,
+		productIdentifier,
+		subscriptionOfferID,
+		strings.ToLower(applicationUsername),
+		strings.ToLower(nonce),
+		strconv.FormatInt(timestamp, 10),
+	}, promotionalOfferPayloadSeparator)
+	digest := sha256.Sum256([]byte(payload))
+	signature, err := ecdsa.SignASN1(rand.Reader, c.privateKey, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,63 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+)
+
+const (
+	testPromotionalOfferNonce     = "A1B2C3D4-E5F6-4A7B-8C9D-0E1F2A3B4C5D"
+	testPromotionalOfferTimestamp = int64(1700000000000)
+	// The payload CreateSignature must sign for the inputs of TestPromotionalOfferSignature: the fields in order, separated by
+	// U+2063 INVISIBLE SEPARATOR, with the username and the nonce lowercased.
+	testPromotionalOfferPayload = "com.example\u2063KEYID\u2063Product\u2063Offer\u2063user\u2063a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d\u20631700000000000"
+)
+
+func verifyPromotionalOfferSignature(t *testing.T, publicKey *ecdsa.PublicKey, payload string, signature string) bool {
+	t.Helper()
+	der, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		t.Fatalf("signature is not base64: %v", err)
+	}
+	digest := sha256.Sum256([]byte(payload))
+	return ecdsa.VerifyASN1(publicKey, digest[:], der)
+}
+
+func TestPromotionalOfferSignature(t *testing.T) {
+	key := newTestSigningKey(t)
+	signature, err := NewPromotionalOfferSignatureCreator(key, "KEYID", "com.example").
+		CreateSignature("Product", "Offer", "USER", testPromotionalOfferNonce, testPromotionalOfferTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !verifyPromotionalOfferSignature(t, &key.PublicKey, testPromotionalOfferPayload, signature) {
+		t.Fatal("signature does not verify against the expected payload")
+	}
+	tests := []struct {
+		name      string
+		publicKey *ecdsa.PublicKey
+		payload   string
+	}{
+		{"changed field", &key.PublicKey, "com.example\u2063KEYID\u2063Product\u2063OtherOffer\u2063user\u2063a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d\u20631700000000000"},
+		{"username not lowercased", &key.PublicKey, "com.example\u2063KEYID\u2063Product\u2063Offer\u2063USER\u2063a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d\u20631700000000000"},
+		{"wrong key", &newTestSigningKey(t).PublicKey, testPromotionalOfferPayload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if verifyPromotionalOfferSignature(t, tt.publicKey, tt.payload, signature) {
+				t.Error("signature verifies")
+			}
+		})
+	}
+}
+
+func TestPromotionalOfferSignatureRejectsInvalidNonce(t *testing.T) {
+	_, err := NewPromotionalOfferSignatureCreator(newTestSigningKey(t), "keyId", "com.example").
+		CreateSignature("productId", "offerId", "", "not-a-uuid", testPromotionalOfferTimestamp)
+	if err == nil {
+		t.Error("expected an error for a nonce that is not a UUID")
+	}
+}
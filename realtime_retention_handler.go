@@ -0,0 +1,148 @@
+package apple_store_server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/verifier"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultRealtimeRetentionTimeout bounds the time the callback may take, so the handler answers
+	// before the App Store stops waiting and shows the default message instead.
+	DefaultRealtimeRetentionTimeout = 500 * time.Millisecond
+
+	maxRealtimeRequestBodySize = 64 << 10
+)
+
+// RealtimeRetentionFunc chooses the retention message for a verified request. Returning a nil
+// response lets the App Store display the default message configured for the product.
+type RealtimeRetentionFunc func(ctx context.Context, request *models.DecodedRealtimeRequestBody) (*models.RealtimeResponseBody, error)
+
+// RealtimeRetentionHandler
+// An http.Handler for the Get Retention Message endpoint the App Store calls in real time.
+// It verifies the signed request, passes the decoded request to the callback and answers with its response.
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/get-retention-message">Get Retention Message</a>
+type RealtimeRetentionHandler struct {
+	verifier    *verifier.SignedDataVerifier
+	callback    RealtimeRetentionFunc
+	offerSigner *PromotionalOfferV2SignatureCreator
+	// The time the callback may take. Defaults to DefaultRealtimeRetentionTimeout.
+	Timeout time.Duration
+}
+
+// NewRealtimeRetentionHandler creates a handler that verifies requests with signedDataVerifier,
+// which should be configured for your bundle ID and environment.
+func NewRealtimeRetentionHandler(signedDataVerifier *verifier.SignedDataVerifier, callback RealtimeRetentionFunc) *RealtimeRetentionHandler {
+	return &RealtimeRetentionHandler{verifier: signedDataVerifier, callback: callback, Timeout: DefaultRealtimeRetentionTimeout}
+}
+
+// WithOfferSigning signs the promotional offers the callback returns with your In-App Purchase key
+// from App Store Connect, for the product and original transaction of the request.
+func (h *RealtimeRetentionHandler) WithOfferSigning(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *RealtimeRetentionHandler {
+	h.offerSigner = NewPromotionalOfferV2SignatureCreator(privateKey, keyId, issuer, bundleId)
+	return h
+}
+
+// readBodyErrorStatus returns the status code to answer when reading a request body failed:
+// 413 when the body exceeds the limit, 400 when it ended before its announced length and 500 otherwise.
+func readBodyErrorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (h *RealtimeRetentionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if http.MethodPost != r.Method {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRealtimeRequestBodySize))
+	if err != nil {
+		logger.Errorf("read realtime retention request failed [%v]", err)
+		w.WriteHeader(readBodyErrorStatus(err))
+		return
+	}
+	requestBody := &models.RealtimeRequestBody{}
+	if err = json.Unmarshal(body, requestBody); err != nil || "" == requestBody.SignedPayload {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	request := &models.DecodedRealtimeRequestBody{}
+	if err = h.verifier.DecodeAndVerifySignedPayload(requestBody.SignedPayload, request); err != nil {
+		logger.Errorf("verify realtime retention request failed [%v]", err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = DefaultRealtimeRetentionTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	type result struct {
+		response *models.RealtimeResponseBody
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		response, err := h.callback(ctx, request)
+		done <- result{response: response, err: err}
+	}()
+
+	var response *models.RealtimeResponseBody
+	select {
+	case <-ctx.Done():
+		logger.Warnf("realtime retention callback timed out, requestIdentifier:%s", request.RequestIdentifier)
+	case res := <-done:
+		if res.err != nil {
+			logger.Errorf("realtime retention callback failed [%v]", res.err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		response = res.response
+	}
+	if nil == response {
+		response = &models.RealtimeResponseBody{}
+	}
+	if err = h.signPromotionalOffer(request, response); err != nil {
+		logger.Errorf("sign promotional offer failed [%v]", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	out, err := json.Marshal(response)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(out)
+}
+
+func (h *RealtimeRetentionHandler) signPromotionalOffer(request *models.DecodedRealtimeRequestBody, response *models.RealtimeResponseBody) error {
+	offer := response.PromotionalOffer
+	if nil == offer || nil == h.offerSigner || "" != offer.PromotionalOfferSignatureV2 || "" == offer.OfferIdentifier {
+		return nil
+	}
+	signature, err := h.offerSigner.CreateSignature(request.ProductId, offer.OfferIdentifier, request.OriginalTransactionId)
+	if err != nil {
+		return err
+	}
+	offer.PromotionalOfferSignatureV2 = signature
+	return nil
+}
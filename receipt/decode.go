@@ -0,0 +1,104 @@
+package receipt
+
+import (
+	"time"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+)
+
+func decodeReceipt(payload []byte) (*models.AppReceipt, error) {
+	attributes, err := internal.ParseReceiptAttributes(payload)
+	if nil != err {
+		return nil, err
+	}
+	receipt := &models.AppReceipt{}
+	for _, attribute := range attributes {
+		switch attribute.Type {
+		case attributeReceiptType:
+			receipt.ReceiptType, err = attribute.String()
+		case attributeBundleId:
+			receipt.BundleIdData = attribute.Value
+			receipt.BundleId, err = attribute.String()
+		case attributeApplicationVersion:
+			receipt.ApplicationVersion, err = attribute.String()
+		case attributeOpaqueValue:
+			receipt.OpaqueValue = attribute.Value
+		case attributeSHA1Hash:
+			receipt.SHA1Hash = attribute.Value
+		case attributeReceiptCreationDate:
+			receipt.ReceiptCreationDate, err = date(attribute)
+		case attributeOriginalPurchaseDate:
+			receipt.OriginalPurchaseDate, err = date(attribute)
+		case attributeOriginalApplicationVersion:
+			receipt.OriginalApplicationVersion, err = attribute.String()
+		case attributeExpirationDate:
+			receipt.ExpirationDate, err = date(attribute)
+		case attributeInApp:
+			var inApp *models.InAppReceipt
+			if inApp, err = decodeInAppReceipt(attribute.Value); nil == err {
+				receipt.InApp = append(receipt.InApp, inApp)
+			}
+		}
+		if nil != err {
+			return nil, err
+		}
+	}
+	return receipt, nil
+}
+
+func decodeInAppReceipt(payload []byte) (*models.InAppReceipt, error) {
+	attributes, err := internal.ParseReceiptAttributes(payload)
+	if nil != err {
+		return nil, err
+	}
+	inApp := &models.InAppReceipt{}
+	var flag int64
+	for _, attribute := range attributes {
+		switch attribute.Type {
+		case attributeQuantity:
+			inApp.Quantity, err = attribute.Int()
+		case attributeProductId:
+			inApp.ProductId, err = attribute.String()
+		case attributeTransactionId:
+			inApp.TransactionId, err = attribute.String()
+		case attributeOriginalTransactionId:
+			inApp.OriginalTransactionId, err = attribute.String()
+		case attributePurchaseDate:
+			inApp.PurchaseDate, err = date(attribute)
+		case attributeOriginalPurchase:
+			inApp.OriginalPurchaseDate, err = date(attribute)
+		case attributeExpiresDate:
+			inApp.ExpiresDate, err = date(attribute)
+		case attributeCancellationDate:
+			inApp.CancellationDate, err = date(attribute)
+		case attributeWebOrderLineItemId:
+			inApp.WebOrderLineItemId, err = attribute.Int()
+		case attributeIsTrialPeriod:
+			flag, err = attribute.Int()
+			inApp.IsTrialPeriod = 0 != flag
+		case attributeIsInIntroOfferPeriod:
+			flag, err = attribute.Int()
+			inApp.IsInIntroOfferPeriod = 0 != flag
+		case attributePromotionalOfferId:
+			inApp.PromotionalOfferId, err = attribute.String()
+		}
+		if nil != err {
+			return nil, err
+		}
+	}
+	return inApp, nil
+}
+
+// date decodes an RFC 3339 date attribute into UNIX milliseconds; an empty date is zero.
+func date(attribute internal.ReceiptAttribute) (int64, error) {
+	s, err := attribute.String()
+	if nil != err || "" == s {
+		return 0, err
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if nil != err {
+		return 0, err
+	}
+	return t.UnixMilli(), nil
+}
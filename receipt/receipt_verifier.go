@@ -0,0 +1,283 @@
+package receipt
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/verifier"
+)
+
+// Receipt attribute types.
+// @see <a href="https://developer.apple.com/library/archive/releasenotes/General/ValidateAppStoreReceipt/Chapters/ReceiptFields.html">Receipt Fields</a>
+const (
+	attributeReceiptType                = 0
+	attributeBundleId                   = 2
+	attributeApplicationVersion         = 3
+	attributeOpaqueValue                = 4
+	attributeSHA1Hash                   = 5
+	attributeReceiptCreationDate        = 12
+	attributeInApp                      = 17
+	attributeOriginalPurchaseDate       = 18
+	attributeOriginalApplicationVersion = 19
+	attributeExpirationDate             = 21
+
+	attributeQuantity              = 1701
+	attributeProductId             = 1702
+	attributeTransactionId         = 1703
+	attributePurchaseDate          = 1704
+	attributeOriginalTransactionId = 1705
+	attributeOriginalPurchase      = 1706
+	attributeExpiresDate           = 1708
+	attributeWebOrderLineItemId    = 1711
+	attributeCancellationDate      = 1712
+	attributeIsTrialPeriod         = 1713
+	attributeIsInIntroOfferPeriod  = 1719
+	attributePromotionalOfferId    = 1721
+
+	maxCertificateChainLength = 5
+
+	// The Apple Inc. Root Certificate, which issues the WWDR intermediate certificates that sign app receipts.
+	appleRootCaBase64Encoded = "MIIEuzCCA6OgAwIBAgIBAjANBgkqhkiG9w0BAQUFADBiMQswCQYDVQQGEwJVUzETMBEGA1UEChMKQXBwbGUgSW5jLjEmMCQGA1UECxMdQXBwbGUgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkxFjAUBgNVBAMTDUFwcGxlIFJvb3QgQ0EwHhcNMDYwNDI1MjE0MDM2WhcNMzUwMjA5MjE0MDM2WjBiMQswCQYDVQQGEwJVUzETMBEGA1UEChMKQXBwbGUgSW5jLjEmMCQGA1UECxMdQXBwbGUgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkxFjAUBgNVBAMTDUFwcGxlIFJvb3QgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDkkakJH5HbHkdQ6wXtXnmELes2oldMVeyLGYne+Uts9QerIjAC6Bg++FAJ039BqJj50cpmnCRrEdCju+QbKsMflZ56DKRHi1vUFjczy8QPTc4UadHJGXL1XQ7Vf1+b8iUDulWPTV0N8WQ1IxVLFVkds5T39pyez1C6wVhQZ48ItCD3y6wsIG9wtj8BMIy3Q88PnT3zK0koGsj+zrW5DtleHNbLPbU6rfQPDgCSC7EhFi501TwN22IWq6NxkkdTVcGvL0Gz+PvjcM3mo0xFfh9Ma1CWQYnEdGILEINBhzOKgbEwWOxaBDKMaLOPHd5lc/9nXmW8Sdh2nzMUZaF3lMktAgMBAAGjggF6MIIBdjAOBgNVHQ8BAf8EBAMCAQYwDwYDVR0TAQH/BAUwAwEB/zAdBgNVHQ4EFgQUK9BpR5R2Cf70a40uQKb3R01/CF4wHwYDVR0jBBgwFoAUK9BpR5R2Cf70a40uQKb3R01/CF4wggERBgNVHSAEggEIMIIBBDCCAQAGCSqGSIb3Y2QFATCB8jAqBggrBgEFBQcCARYeaHR0cHM6Ly93d3cuYXBwbGUuY29tL2FwcGxlY2EvMIHDBggrBgEFBQcCAjCBthqBs1JlbGlhbmNlIG9uIHRoaXMgY2VydGlmaWNhdGUgYnkgYW55IHBhcnR5IGFzc3VtZXMgYWNjZXB0YW5jZSBvZiB0aGUgdGhlbiBhcHBsaWNhYmxlIHN0YW5kYXJkIHRlcm1zIGFuZCBjb25kaXRpb25zIG9mIHVzZSwgY2VydGlmaWNhdGUgcG9saWN5IGFuZCBjZXJ0aWZpY2F0aW9uIHByYWN0aWNlIHN0YXRlbWVudHMuMA0GCSqGSIb3DQEBBQUAA4IBAQBcNplMLXi37Yyb3PN3m/J20ncwT8EfhYOFG5k9RzfyqZtAjizUsZAS2L70c5vu0mQPy3lPNNiiPvl4/2vIB+x9OYOLUyDTOMSxv5pPCmv/K/xZpwUJfBdAVhEedNO3iyM7R6PVbyTi69G3cN8PReEnyvFteO3ntRcXqNx+IjXKJdXZD9Zr1KIkIxH3oayPc4FgxhtbCS+SsvhESPBgOJ4V9T0mZyCKM2r3DYLP3uujL/lTaltkwGMzd/c6ByxW69oPIQ7aunMZT7XZNn/Bh1XZp5m5MkL72NVxnn6hUrcbvZNCJBIqxw8dtk2cXmPIS4AXUKqK1drk/NAJBzewdXUh"
+)
+
+var (
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+
+	// Marker extensions of the App Store receipt signing certificate and of the WWDR intermediate certificate that issues it.
+	oidReceiptSigningMarker   = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 11, 1}
+	oidWWDRIntermediateMarker = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 2, 1}
+
+	ErrInvalidDeviceHash = errors.New("receipt: SHA-1 hash does not match the device")
+)
+
+// ReceiptVerifier
+// Verifies the PKCS#7 signature of StoreKit 1 app receipts and decodes them, without calling the App Store.
+// The certificate chain is checked against the given roots, the Apple Inc. Root Certificate for receipts
+// signed by the App Store, at the time the receipt was created. The signing certificate and the intermediate
+// certificate that issues it must carry the marker extensions of the App Store receipt signing and WWDR certificates.
+// @see <a href="https://www.apple.com/certificateauthority/">Apple PKI</a>
+type ReceiptVerifier struct {
+	rootCertificates []*x509.Certificate
+	bundleId         string
+}
+
+// NewReceiptVerifier creates a verifier for the receipts of your app. An empty bundleId skips the bundle id check.
+func NewReceiptVerifier(rootCertificates []*x509.Certificate, bundleId string) *ReceiptVerifier {
+	return &ReceiptVerifier{rootCertificates: rootCertificates, bundleId: bundleId}
+}
+
+// NewReceiptVerifierWithDefault creates a verifier that trusts the embedded Apple Inc. Root Certificate.
+func NewReceiptVerifierWithDefault(bundleId string) *ReceiptVerifier {
+	rootCertificates, _ := verifier.CertFromBase64(appleRootCaBase64Encoded)
+	return NewReceiptVerifier(rootCertificates, bundleId)
+}
+
+// VerifyAndDecodeReceipt
+// Verify the signature of a base64 encoded app receipt and decode its fields.
+// @param appReceipt The unmodified app receipt
+// @return The decoded receipt
+func (v *ReceiptVerifier) VerifyAndDecodeReceipt(appReceipt string) (*models.AppReceipt, error) {
+	signedData, err := parseReceipt(appReceipt)
+	if nil != err {
+		return nil, err
+	}
+	receipt, err := decodeReceipt(signedData.Content)
+	if nil != err {
+		return nil, err
+	}
+	at := time.Now()
+	if 0 != receipt.ReceiptCreationDate {
+		at = time.UnixMilli(receipt.ReceiptCreationDate)
+	}
+	if err = v.verifySignature(signedData, at); nil != err {
+		return nil, err
+	}
+	if "" != v.bundleId && receipt.BundleId != v.bundleId {
+		return nil, fmt.Errorf("bundle id mismatch: got %q want %q", receipt.BundleId, v.bundleId)
+	}
+	return receipt, nil
+}
+
+// DecodeReceipt
+// Decode the fields of a base64 encoded app receipt. *NO validation* is performed on the receipt.
+// @param appReceipt The unmodified app receipt
+// @return The decoded receipt
+func DecodeReceipt(appReceipt string) (*models.AppReceipt, error) {
+	signedData, err := parseReceipt(appReceipt)
+	if nil != err {
+		return nil, err
+	}
+	return decodeReceipt(signedData.Content)
+}
+
+// ValidateDeviceHash
+// Check that the receipt was issued to the device, by comparing its SHA-1 hash with the one computed from
+// the device identifier, the opaque value and the bundle identifier.
+// @param deviceIdentifier The bytes of identifierForVendor on iOS, or of the MAC address of the primary network interface on macOS.
+// @return ErrInvalidDeviceHash if the hash does not match
+func ValidateDeviceHash(receipt *models.AppReceipt, deviceIdentifier []byte) error {
+	h := sha1.New()
+	h.Write(deviceIdentifier)
+	h.Write(receipt.OpaqueValue)
+	h.Write(receipt.BundleIdData)
+	if !bytes.Equal(h.Sum(nil), receipt.SHA1Hash) {
+		return ErrInvalidDeviceHash
+	}
+	return nil
+}
+
+// DeviceIdentifierFromUUID returns the bytes of an identifierForVendor UUID string.
+func DeviceIdentifierFromUUID(uuid string) ([]byte, error) {
+	if !internal.IsUUID(uuid) {
+		return nil, fmt.Errorf("receipt: invalid device identifier %q", uuid)
+	}
+	return hex.DecodeString(strings.ReplaceAll(uuid, "-", ""))
+}
+
+func parseReceipt(appReceipt string) (*internal.SignedData, error) {
+	der, err := base64.StdEncoding.DecodeString(appReceipt)
+	if nil != err {
+		return nil, errors.New("receipt: not valid base64")
+	}
+	return internal.ParseSignedData(der)
+}
+
+func (v *ReceiptVerifier) verifySignature(signedData *internal.SignedData, at time.Time) error {
+	if 1 != len(signedData.SignerInfos) {
+		return errors.New("receipt: expected exactly one signer")
+	}
+	signer := signedData.SignerInfos[0]
+	var certificates []*x509.Certificate
+	for _, raw := range signedData.Certificates {
+		certificate, err := x509.ParseCertificate(raw)
+		if nil != err {
+			return err
+		}
+		certificates = append(certificates, certificate)
+	}
+	var leaf *x509.Certificate
+	for _, certificate := range certificates {
+		if bytes.Equal(certificate.RawIssuer, signer.Issuer) && 0 == certificate.SerialNumber.Cmp(signer.SerialNumber) {
+			leaf = certificate
+			break
+		}
+	}
+	if nil == leaf {
+		return errors.New("receipt: signer certificate not found")
+	}
+	if err := v.verifyCertificateChain(leaf, certificates, at); nil != err {
+		return err
+	}
+
+	var h hash.Hash
+	switch {
+	case signer.DigestAlgorithm.Equal(oidSHA1):
+		h = sha1.New()
+	case signer.DigestAlgorithm.Equal(oidSHA256):
+		h = sha256.New()
+	default:
+		return fmt.Errorf("receipt: unsupported digest algorithm %v", signer.DigestAlgorithm)
+	}
+	signed := signedData.Content
+	if nil != signer.SignedAttributes {
+		h.Write(signedData.Content)
+		if !bytes.Equal(h.Sum(nil), signer.MessageDigest) {
+			return errors.New("receipt: message digest mismatch")
+		}
+		signed = signer.SignedAttributes
+	}
+	algorithm, err := signatureAlgorithm(leaf, signer.DigestAlgorithm)
+	if nil != err {
+		return err
+	}
+	if err = leaf.CheckSignature(algorithm, signed, signer.Signature); nil != err {
+		return fmt.Errorf("receipt: invalid signature: %w", err)
+	}
+	return nil
+}
+
+// verifyCertificateChain walks from the leaf to one of the roots. x509.Certificate.Verify is not used because
+// it rejects the SHA-1 signatures of the intermediate certificates of older receipts and only checks validity at a single time.
+func (v *ReceiptVerifier) verifyCertificateChain(leaf *x509.Certificate, intermediates []*x509.Certificate, at time.Time) error {
+	if !hasExtension(leaf, oidReceiptSigningMarker) {
+		return errors.New("receipt: signer is not an App Store receipt signing certificate")
+	}
+	certificate := leaf
+	for i := 0; i < maxCertificateChainLength; i++ {
+		if at.Before(certificate.NotBefore) || at.After(certificate.NotAfter) {
+			return fmt.Errorf("receipt: certificate %q is not valid at %s", certificate.Subject.CommonName, at.Format(time.RFC3339))
+		}
+		if i > 0 && (!certificate.BasicConstraintsValid || !certificate.IsCA) {
+			return fmt.Errorf("receipt: certificate %q is not a CA", certificate.Subject.CommonName)
+		}
+		if 1 == i && !hasExtension(certificate, oidWWDRIntermediateMarker) {
+			return errors.New("receipt: signer certificate is not issued by a WWDR intermediate certificate")
+		}
+		// The leaf is never trusted directly: it must be issued by a WWDR intermediate certificate.
+		if i > 0 {
+			for _, root := range v.rootCertificates {
+				if certificate.Equal(root) || issuedBy(certificate, root) {
+					return nil
+				}
+			}
+		}
+		var parent *x509.Certificate
+		for _, candidate := range intermediates {
+			if candidate != certificate && issuedBy(certificate, candidate) {
+				parent = candidate
+				break
+			}
+		}
+		if nil == parent {
+			return errors.New("receipt: certificate chain does not lead to a trusted root")
+		}
+		certificate = parent
+	}
+	return errors.New("receipt: certificate chain too long")
+}
+
+func hasExtension(certificate *x509.Certificate, oid asn1.ObjectIdentifier) bool {
+	for _, extension := range certificate.Extensions {
+		if extension.Id.Equal(oid) {
+			return true
+		}
+	}
+	return false
+}
+
+func issuedBy(certificate, parent *x509.Certificate) bool {
+	return bytes.Equal(certificate.RawIssuer, parent.RawSubject) &&
+		nil == parent.CheckSignature(certificate.SignatureAlgorithm, certificate.RawTBSCertificate, certificate.Signature)
+}
+
+func signatureAlgorithm(certificate *x509.Certificate, digestAlgorithm asn1.ObjectIdentifier) (x509.SignatureAlgorithm, error) {
+	sha1Digest := digestAlgorithm.Equal(oidSHA1)
+	switch certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if sha1Digest {
+			return x509.SHA1WithRSA, nil
+		}
+		return x509.SHA256WithRSA, nil
+	case *ecdsa.PublicKey:
+		if sha1Digest {
+			return x509.ECDSAWithSHA1, nil
+		}
+		return x509.ECDSAWithSHA256, nil
+	}
+	return x509.UnknownSignatureAlgorithm, errors.New("receipt: unsupported signer public key")
+}
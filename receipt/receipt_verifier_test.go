@@ -0,0 +1,305 @@
+package receipt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+var (
+	oidData       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	markerValue   = []byte{0x05, 0x00}
+)
+
+type testAttribute struct {
+	Type    int
+	Version int
+	Value   []byte
+}
+
+type testAlgorithmIdentifier struct {
+	Algorithm asn1.ObjectIdentifier
+}
+
+type testIssuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type testSignedAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+type testSignerInfo struct {
+	Version            int
+	Sid                testIssuerAndSerialNumber
+	DigestAlgorithm    testAlgorithmIdentifier
+	SignedAttributes   []testSignedAttribute `asn1:"optional,omitempty,tag:0,set"`
+	SignatureAlgorithm testAlgorithmIdentifier
+	Signature          []byte
+}
+
+type testEncapsulatedContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     []byte `asn1:"explicit,tag:0"`
+}
+
+type testSignedData struct {
+	Version          int
+	DigestAlgorithms []testAlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo testEncapsulatedContentInfo
+	Certificates     []asn1.RawValue  `asn1:"tag:0"`
+	SignerInfos      []testSignerInfo `asn1:"set"`
+}
+
+type testContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     testSignedData `asn1:"explicit,tag:0"`
+}
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCertificate(t *testing.T, name string, parent *testCertificate, isCA bool, marker asn1.ObjectIdentifier) *testCertificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if nil != marker {
+		template.ExtraExtensions = []pkix.Extension{{Id: marker, Value: markerValue}}
+	}
+	issuer, issuerKey := template, key
+	if nil != parent {
+		issuer, issuerKey = parent.certificate, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{certificate: certificate, key: key}
+}
+
+func marshal(t *testing.T, value interface{}, params string) []byte {
+	t.Helper()
+	der, err := asn1.MarshalWithParams(value, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func newTestPayload(t *testing.T, extra ...testAttribute) []byte {
+	t.Helper()
+	inApp := marshal(t, []testAttribute{
+		{Type: attributeTransactionId, Version: 1, Value: marshal(t, "2000000000000001", "utf8")},
+		{Type: attributeProductId, Version: 1, Value: marshal(t, "com.example.product", "utf8")},
+		{Type: attributeQuantity, Version: 1, Value: marshal(t, 1, "")},
+	}, "set")
+	return marshal(t, append([]testAttribute{
+		{Type: attributeBundleId, Version: 1, Value: marshal(t, "com.example", "utf8")},
+		{Type: attributeReceiptCreationDate, Version: 1, Value: marshal(t, time.Now().UTC().Format(time.RFC3339), "ia5")},
+		{Type: attributeInApp, Version: 1, Value: inApp},
+	}, extra...), "set")
+}
+
+// testReceiptOptions describes how to build a test receipt. The zero values sign the content with the signer key.
+type testReceiptOptions struct {
+	// signedContent replaces the content when computing the signature, to model a tampered payload.
+	signedContent []byte
+	// signingKey replaces the key of the signer certificate.
+	signingKey *ecdsa.PrivateKey
+	// signedAttributes signs a messageDigest attribute instead of the content directly.
+	signedAttributes bool
+}
+
+// newTestReceipt signs payload with signer, without signed attributes, and embeds certificates.
+func newTestReceipt(t *testing.T, payload []byte, signer *testCertificate, certificates ...*testCertificate) string {
+	t.Helper()
+	return newTestReceiptWithOptions(t, payload, testReceiptOptions{}, signer, certificates...)
+}
+
+func newTestReceiptWithOptions(t *testing.T, payload []byte, options testReceiptOptions, signer *testCertificate, certificates ...*testCertificate) string {
+	t.Helper()
+	signed := payload
+	if nil != options.signedContent {
+		signed = options.signedContent
+	}
+	key := signer.key
+	if nil != options.signingKey {
+		key = options.signingKey
+	}
+	var signedAttributes []testSignedAttribute
+	if options.signedAttributes {
+		messageDigest := sha256.Sum256(signed)
+		signedAttributes = []testSignedAttribute{{
+			Type:   asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4},
+			Values: []asn1.RawValue{{FullBytes: marshal(t, messageDigest[:], "")}},
+		}}
+		// The signature covers the DER encoding of the attributes with the SET OF tag.
+		signed = marshal(t, signedAttributes, "set")
+	}
+	digest := sha256.Sum256(signed)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rawCertificates []asn1.RawValue
+	for _, certificate := range certificates {
+		rawCertificates = append(rawCertificates, asn1.RawValue{FullBytes: certificate.certificate.Raw})
+	}
+	contentInfo := testContentInfo{
+		ContentType: oidSignedData,
+		Content: testSignedData{
+			Version:          1,
+			DigestAlgorithms: []testAlgorithmIdentifier{{Algorithm: oidSHA256}},
+			EncapContentInfo: testEncapsulatedContentInfo{ContentType: oidData, Content: payload},
+			Certificates:     rawCertificates,
+			SignerInfos: []testSignerInfo{{
+				Version: 1,
+				Sid: testIssuerAndSerialNumber{
+					Issuer:       asn1.RawValue{FullBytes: signer.certificate.RawIssuer},
+					SerialNumber: signer.certificate.SerialNumber,
+				},
+				DigestAlgorithm:    testAlgorithmIdentifier{Algorithm: oidSHA256},
+				SignedAttributes:   signedAttributes,
+				SignatureAlgorithm: testAlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}},
+				Signature:          signature,
+			}},
+		},
+	}
+	return base64.StdEncoding.EncodeToString(marshal(t, contentInfo, ""))
+}
+
+func TestVerifyAndDecodeReceipt(t *testing.T) {
+	root := newTestCertificate(t, "root", nil, true, nil)
+	intermediate := newTestCertificate(t, "wwdr", root, true, oidWWDRIntermediateMarker)
+	leaf := newTestCertificate(t, "receipt signing", intermediate, false, oidReceiptSigningMarker)
+	payload := newTestPayload(t)
+
+	tests := []struct {
+		name    string
+		options testReceiptOptions
+	}{
+		{"content signature", testReceiptOptions{}},
+		{"signed attributes", testReceiptOptions{signedAttributes: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipt, err := NewReceiptVerifier([]*x509.Certificate{root.certificate}, "com.example").
+				VerifyAndDecodeReceipt(newTestReceiptWithOptions(t, payload, tt.options, leaf, leaf, intermediate, root))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if "com.example" != receipt.BundleId || 1 != len(receipt.InApp) {
+				t.Fatalf("unexpected receipt %+v", receipt)
+			}
+			if inApp := receipt.InApp[0]; "2000000000000001" != inApp.TransactionId || "com.example.product" != inApp.ProductId || 1 != inApp.Quantity {
+				t.Errorf("unexpected in-app receipt %+v", inApp)
+			}
+		})
+	}
+}
+
+func TestVerifyAndDecodeReceiptRejects(t *testing.T) {
+	root := newTestCertificate(t, "root", nil, true, nil)
+	intermediate := newTestCertificate(t, "wwdr", root, true, oidWWDRIntermediateMarker)
+	leaf := newTestCertificate(t, "receipt signing", intermediate, false, oidReceiptSigningMarker)
+	unmarkedIntermediate := newTestCertificate(t, "developer ca", root, true, nil)
+	leafOfUnmarked := newTestCertificate(t, "receipt signing", unmarkedIntermediate, false, oidReceiptSigningMarker)
+	unmarkedLeaf := newTestCertificate(t, "developer", intermediate, false, nil)
+	leafOfRoot := newTestCertificate(t, "receipt signing", root, false, oidReceiptSigningMarker)
+	trusted := []*x509.Certificate{root.certificate}
+	untrusted := []*x509.Certificate{newTestCertificate(t, "other root", nil, true, nil).certificate}
+	payload := newTestPayload(t)
+	tampered := newTestPayload(t, testAttribute{Type: attributeOriginalApplicationVersion, Version: 1, Value: marshal(t, "2.0", "utf8")})
+
+	tests := []struct {
+		name     string
+		receipt  string
+		roots    []*x509.Certificate
+		bundleId string
+	}{
+		{"leaf without marker", newTestReceipt(t, payload, unmarkedLeaf, unmarkedLeaf, intermediate, root), trusted, ""},
+		{"intermediate without marker", newTestReceipt(t, payload, leafOfUnmarked, leafOfUnmarked, unmarkedIntermediate, root), trusted, ""},
+		{"leaf issued by root", newTestReceipt(t, payload, leafOfRoot, leafOfRoot, root), trusted, ""},
+		{"untrusted root", newTestReceipt(t, payload, leaf, leaf, intermediate), untrusted, "com.example"},
+		{"wrong bundleId", newTestReceipt(t, payload, leaf, leaf, intermediate, root), trusted, "com.example.other"},
+		{"tampered payload", newTestReceiptWithOptions(t, tampered, testReceiptOptions{signedContent: payload}, leaf, leaf, intermediate, root), trusted, "com.example"},
+		{"signed by intermediate key", newTestReceiptWithOptions(t, payload, testReceiptOptions{signingKey: intermediate.key}, leaf, leaf, intermediate, root), trusted, "com.example"},
+		{"tampered payload with signed attributes", newTestReceiptWithOptions(t, tampered, testReceiptOptions{signedContent: payload, signedAttributes: true}, leaf, leaf, intermediate, root), trusted, "com.example"},
+		{"signed attributes signed by root key", newTestReceiptWithOptions(t, payload, testReceiptOptions{signingKey: root.key, signedAttributes: true}, leaf, leaf, intermediate, root), trusted, "com.example"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewReceiptVerifier(tt.roots, tt.bundleId).VerifyAndDecodeReceipt(tt.receipt); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestValidateDeviceHash(t *testing.T) {
+	deviceIdentifier, err := DeviceIdentifierFromUUID("A1B2C3D4-E5F6-4A7B-8C9D-0E1F2A3B4C5D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opaqueValue := []byte{0x01, 0x02, 0x03, 0x04}
+	bundleIdData := marshal(t, "com.example", "utf8")
+	h := sha1.New()
+	h.Write(deviceIdentifier)
+	h.Write(opaqueValue)
+	h.Write(bundleIdData)
+	receipt, err := DecodeReceipt(newTestReceipt(t, marshal(t, []testAttribute{
+		{Type: attributeBundleId, Version: 1, Value: bundleIdData},
+		{Type: attributeOpaqueValue, Version: 1, Value: opaqueValue},
+		{Type: attributeSHA1Hash, Version: 1, Value: h.Sum(nil)},
+	}, "set"), newTestCertificate(t, "signer", nil, false, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = ValidateDeviceHash(receipt, deviceIdentifier); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	otherDevice, _ := DeviceIdentifierFromUUID("00000000-0000-4000-8000-000000000000")
+	if err = ValidateDeviceHash(receipt, otherDevice); !errors.Is(err, ErrInvalidDeviceHash) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidDeviceHash)
+	}
+	if _, err = DeviceIdentifierFromUUID("not-a-uuid"); err == nil {
+		t.Error("expected an error for an invalid device identifier")
+	}
+}
+
+func TestNewReceiptVerifierWithDefault(t *testing.T) {
+	v := NewReceiptVerifierWithDefault("com.example")
+	if 1 != len(v.rootCertificates) || "Apple Root CA" != v.rootCertificates[0].Subject.CommonName {
+		t.Fatalf("unexpected default roots %v", v.rootCertificates)
+	}
+}
@@ -0,0 +1,102 @@
+package apple_store_server
+
+import (
+	"encoding/base64"
+	"errors"
+	"regexp"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+)
+
+const (
+	inAppReceiptAttributeType       = 17
+	inAppTransactionIdAttributeType = 1703
+)
+
+var (
+	errReceiptNotBase64 = errors.New("receipt: not valid base64")
+
+	purchaseInfoPattern  = regexp.MustCompile(`"purchase-info"\s+=\s+"([a-zA-Z0-9+/=]+)";`)
+	transactionIdPattern = regexp.MustCompile(`"transaction-id"\s+=\s+"([a-zA-Z0-9+/=]+)";`)
+)
+
+// ReceiptUtility
+// Extracts transaction identifiers from the receipts of StoreKit 1 apps, without calling the App Store.
+// The identifiers can be passed to AppStoreServerAPIClient.GetTransactionInfo or GetTransactionHistory.
+// The receipts are not verified, use them only to look up transactions through the App Store Server API.
+// @see <a href="https://developer.apple.com/documentation/appstoreserverapi/get_transaction_history">Get Transaction History</a>
+type ReceiptUtility struct {
+}
+
+func NewReceiptUtility() *ReceiptUtility {
+	return &ReceiptUtility{}
+}
+
+// ExtractTransactionIdFromAppReceipt
+// Extracts a transaction id from an encoded App Receipt.
+// *NO validation* is performed on the receipt, and any data returned should only be used to call the App Store Server API.
+// @param appReceipt The unmodified app receipt
+// @return A transaction id from the array of in-app purchases, empty if the receipt contains no in-app purchase.
+// An empty string and an error are returned when the receipt does not match the expected format.
+func (u *ReceiptUtility) ExtractTransactionIdFromAppReceipt(appReceipt string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(appReceipt)
+	if nil != err {
+		return "", errReceiptNotBase64
+	}
+	signedData, err := internal.ParseSignedData(der)
+	if nil != err {
+		return "", err
+	}
+	attributes, err := internal.ParseReceiptAttributes(signedData.Content)
+	if nil != err {
+		return "", err
+	}
+	for _, attribute := range attributes {
+		if inAppReceiptAttributeType != attribute.Type {
+			continue
+		}
+		inAppAttributes, err := internal.ParseReceiptAttributes(attribute.Value)
+		if nil != err {
+			return "", err
+		}
+		for _, inAppAttribute := range inAppAttributes {
+			if inAppTransactionIdAttributeType != inAppAttribute.Type {
+				continue
+			}
+			transactionId, err := inAppAttribute.String()
+			if nil != err {
+				return "", err
+			}
+			if "" != transactionId {
+				return transactionId, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// ExtractTransactionIdFromTransactionReceipt
+// Extracts a transaction id from an encoded transactional receipt.
+// *NO validation* is performed on the receipt, and any data returned should only be used to call the App Store Server API.
+// @param transactionReceipt The unmodified transactionReceipt
+// @return A transaction id, empty if no transactionId is found in the receipt.
+// An empty string and an error are returned when the receipt or its purchase info is not valid base64.
+func (u *ReceiptUtility) ExtractTransactionIdFromTransactionReceipt(transactionReceipt string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(transactionReceipt)
+	if nil != err {
+		return "", errReceiptNotBase64
+	}
+	match := purchaseInfoPattern.FindSubmatch(decoded)
+	if nil == match {
+		return "", nil
+	}
+	purchaseInfo, err := base64.StdEncoding.DecodeString(string(match[1]))
+	if nil != err {
+		return "", errReceiptNotBase64
+	}
+	match = transactionIdPattern.FindSubmatch(purchaseInfo)
+	if nil == match {
+		return "", nil
+	}
+	return string(match[1]), nil
+}
@@ -0,0 +1,127 @@
+package apple_store_server
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/base64"
+	"testing"
+)
+
+// berElement encodes a definite-length element with the given identifier octet.
+func berElement(identifier byte, content ...[]byte) []byte {
+	joined := bytes.Join(content, nil)
+	length := len(joined)
+	var header []byte
+	switch {
+	case length < 0x80:
+		header = []byte{identifier, byte(length)}
+	case length < 0x100:
+		header = []byte{identifier, 0x81, byte(length)}
+	default:
+		header = []byte{identifier, 0x82, byte(length >> 8), byte(length)}
+	}
+	return append(header, joined...)
+}
+
+// berIndefinite encodes a constructed element in the indefinite-length form, as app receipts do.
+func berIndefinite(identifier byte, content ...[]byte) []byte {
+	out := append([]byte{identifier, 0x80}, bytes.Join(content, nil)...)
+	return append(out, 0, 0)
+}
+
+func berOID(t *testing.T, oid asn1.ObjectIdentifier) []byte {
+	t.Helper()
+	der, err := asn1.Marshal(oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func receiptAttribute(typ int, value []byte) []byte {
+	return berElement(0x30, berElement(0x02, []byte{byte(typ >> 8), byte(typ)}), berElement(0x02, []byte{1}), berElement(0x04, value))
+}
+
+// newTestAppReceipt wraps the payload in an unsigned PKCS#7 signed-data message, in the indefinite-length form.
+func newTestAppReceipt(t *testing.T, payload []byte) string {
+	t.Helper()
+	signedData := berIndefinite(0x30,
+		berElement(0x02, []byte{1}),
+		berElement(0x31),
+		berIndefinite(0x30,
+			berOID(t, asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}),
+			berIndefinite(0xa0, berIndefinite(0x24, berElement(0x04, payload[:len(payload)/2]), berElement(0x04, payload[len(payload)/2:]))),
+		),
+		berElement(0x31),
+	)
+	contentInfo := berIndefinite(0x30, berOID(t, asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}), berIndefinite(0xa0, signedData))
+	return base64.StdEncoding.EncodeToString(contentInfo)
+}
+
+func TestExtractTransactionIdFromAppReceipt(t *testing.T) {
+	bundleId := receiptAttribute(2, berElement(0x0c, []byte("com.example")))
+	inApp := receiptAttribute(17, berElement(0x31,
+		receiptAttribute(1702, berElement(0x0c, []byte("com.example.product"))),
+		receiptAttribute(1703, berElement(0x0c, []byte("2000000000000001"))),
+	))
+	deep := bytes.Repeat([]byte{0x30, 0x80}, 100000)
+
+	tests := []struct {
+		name          string
+		receipt       string
+		transactionId string
+		wantErr       bool
+	}{
+		{"in-app purchase", newTestAppReceipt(t, berElement(0x31, bundleId, inApp)), "2000000000000001", false},
+		{"no in-app purchases", newTestAppReceipt(t, berElement(0x31, bundleId)), "", false},
+		{"not base64", "not base64!", "", true},
+		{"truncated", newTestAppReceipt(t, berElement(0x31, bundleId, inApp))[:40], "", true},
+		{"not signed data", base64.StdEncoding.EncodeToString(berElement(0x30, berElement(0x02, []byte{1}))), "", true},
+		{"malformed payload", newTestAppReceipt(t, []byte{0x31, 0x05, 0x30}), "", true},
+		{"indefinite-length primitive", newTestAppReceipt(t, berElement(0x31, []byte{0x30, 0x80, 0x02, 0x80, 0x01, 0x00, 0x00})), "", true},
+		{"deep nesting", base64.StdEncoding.EncodeToString(deep), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionId, err := NewReceiptUtility().ExtractTransactionIdFromAppReceipt(tt.receipt)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if tt.transactionId != transactionId {
+				t.Errorf("got transaction id %q, want %q", transactionId, tt.transactionId)
+			}
+		})
+	}
+}
+
+func TestExtractTransactionIdFromTransactionReceipt(t *testing.T) {
+	purchaseInfo := base64.StdEncoding.EncodeToString([]byte("{\n\t\"original-transaction-id\" = \"1000000000000000\";\n\t\"transaction-id\" = \"1000000000000001\";\n}"))
+	noTransactionId := base64.StdEncoding.EncodeToString([]byte("{\n\t\"product-id\" = \"com.example.product\";\n}"))
+	transactionReceipt := func(purchaseInfo string) string {
+		return base64.StdEncoding.EncodeToString([]byte("{\n\t\"signature\" = \"AAAA\";\n\t\"purchase-info\" = \"" + purchaseInfo + "\";\n\t\"environment\" = \"Sandbox\";\n}"))
+	}
+
+	tests := []struct {
+		name          string
+		receipt       string
+		transactionId string
+		wantErr       bool
+	}{
+		{"transaction id", transactionReceipt(purchaseInfo), "1000000000000001", false},
+		{"no transaction id", transactionReceipt(noTransactionId), "", false},
+		{"no purchase info", base64.StdEncoding.EncodeToString([]byte("{\n\t\"signature\" = \"AAAA\";\n}")), "", false},
+		{"purchase info not base64", transactionReceipt("A"), "", true},
+		{"not base64", "not base64!", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionId, err := NewReceiptUtility().ExtractTransactionIdFromTransactionReceipt(tt.receipt)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if tt.transactionId != transactionId {
+				t.Errorf("got transaction id %q, want %q", transactionId, tt.transactionId)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package apple_store_server
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+
+	"github.com/meetleev/go-apple-store-server/internal"
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	logger "github.com/sirupsen/logrus"
+)
+
+// RetentionMessagingAPIClient
+// A client for the Retention Messaging API. It shares the bearer token and request pipeline of AppStoreServerAPIClient.
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging">Retention Messaging API</a>
+type RetentionMessagingAPIClient struct {
+	api *AppStoreServerAPIClient
+}
+
+func NewRetentionMessagingAPIClientWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId string, environment types.Environment) (*RetentionMessagingAPIClient, error) {
+	api, err := NewAPIClientWithLocalPrivateKeyFilePath(privateKeyFilePath, keyId, issuer, bundleId, environment)
+	if nil != err {
+		return nil, err
+	}
+	return &RetentionMessagingAPIClient{api: api}, nil
+}
+
+func NewRetentionMessagingAPIClient(privateKey *ecdsa.PrivateKey, keyId, issuer, bundleId string) *RetentionMessagingAPIClient {
+	return &RetentionMessagingAPIClient{api: NewAPIClient(privateKey, keyId, issuer, bundleId)}
+}
+
+func (c *RetentionMessagingAPIClient) SetEnv(environment types.Environment) {
+	c.api.SetEnv(environment)
+}
+
+// UploadImage
+// Upload an image to use for retention messaging.
+// @param imageIdentifier A UUID you provide to uniquely identify the image you upload.
+// @param image The image file to upload, in PNG format.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/upload-image">Upload image</a>
+func (c *RetentionMessagingAPIClient) UploadImage(imageIdentifier string, image []byte) error {
+	if !internal.IsUUID(imageIdentifier) {
+		return fmt.Errorf("imageIdentifier must be a UUID: %q", imageIdentifier)
+	}
+	_, err := c.api.makeRequest(fmt.Sprintf("/inApps/v1/messaging/image/%s", imageIdentifier), "PUT", internal.WithRawBody("image/png", image))
+	return err
+}
+
+// DeleteImage
+// Delete a previously uploaded image.
+// @param imageIdentifier The identifier of the image to delete.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/delete-image">Delete image</a>
+func (c *RetentionMessagingAPIClient) DeleteImage(imageIdentifier string) error {
+	_, err := c.api.makeRequest(fmt.Sprintf("/inApps/v1/messaging/image/%s", imageIdentifier), "DELETE")
+	return err
+}
+
+// GetImageList
+// Get the image identifier and state for all uploaded images.
+// @return A response that contains status information for all images.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/get-image-list">Get image list</a>
+func (c *RetentionMessagingAPIClient) GetImageList() (*models.GetImageListResponse, error) {
+	body, err := c.api.makeRequest("/inApps/v1/messaging/image/list", "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.GetImageListResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse ImageList response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UploadMessage
+// Upload a message to use for retention messaging.
+// @param messageIdentifier A UUID you provide to uniquely identify the message you upload.
+// @param request The message text and an optional image reference.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/upload-message">Upload message</a>
+func (c *RetentionMessagingAPIClient) UploadMessage(messageIdentifier string, request *models.UploadMessageRequestBody) error {
+	if !internal.IsUUID(messageIdentifier) {
+		return fmt.Errorf("messageIdentifier must be a UUID: %q", messageIdentifier)
+	}
+	_, err := c.api.makeRequest(fmt.Sprintf("/inApps/v1/messaging/message/%s", messageIdentifier), "PUT", internal.WithBody(request))
+	return err
+}
+
+// DeleteMessage
+// Delete a previously uploaded message.
+// @param messageIdentifier The identifier of the message to delete.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/delete-message">Delete message</a>
+func (c *RetentionMessagingAPIClient) DeleteMessage(messageIdentifier string) error {
+	_, err := c.api.makeRequest(fmt.Sprintf("/inApps/v1/messaging/message/%s", messageIdentifier), "DELETE")
+	return err
+}
+
+// GetMessageList
+// Get the message identifier and state of all uploaded messages.
+// @return A response that contains status information for all messages.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/get-message-list">Get message list</a>
+func (c *RetentionMessagingAPIClient) GetMessageList() (*models.GetMessageListResponse, error) {
+	body, err := c.api.makeRequest("/inApps/v1/messaging/message/list", "GET")
+	if nil != err {
+		return nil, err
+	}
+	response := &models.GetMessageListResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse MessageList response body failed [%v]", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ConfigureDefaultMessage
+// Configure a default message for a specific product in a specific locale.
+// @param productId The product identifier for the default configuration.
+// @param locale The locale for the default configuration.
+// @param request The request body that includes the message identifier to configure as the default message.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/configure-default-message">Configure default message</a>
+func (c *RetentionMessagingAPIClient) ConfigureDefaultMessage(productId, locale string, request *models.DefaultConfigurationRequest) error {
+	_, err := c.api.makeRequest(fmt.Sprintf("/inApps/v1/messaging/default/%s/%s", productId, locale), "PUT", internal.WithBody(request))
+	return err
+}
+
+// DeleteDefaultMessage
+// Delete a default message for a product in a locale.
+// @param productId The product identifier for the default configuration.
+// @param locale The locale for the default configuration.
+// @throws APIException If a response was returned indicating the request could not be processed
+// @see <a href="https://developer.apple.com/documentation/retentionmessaging/delete-default-message">Delete default message</a>
+func (c *RetentionMessagingAPIClient) DeleteDefaultMessage(productId, locale string) error {
+	_, err := c.api.makeRequest(fmt.Sprintf("/inApps/v1/messaging/default/%s/%s", productId, locale), "DELETE")
+	return err
+}
@@ -0,0 +1,56 @@
+package apple_store_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// DefaultTestNotificationPollInterval is used when SendTestNotificationAndWait is given no poll interval.
+const DefaultTestNotificationPollInterval = 5 * time.Second
+
+// SendTestNotificationAndWait
+// Requests a test notification for the client's environment and polls its status until the App Store records a send attempt or ctx is done.
+// The request is configured in App Store Connect per environment, so use a client created for the environment whose URL you want to check.
+// @param pollInterval How often to check the status. Defaults to DefaultTestNotificationPollInterval.
+// Polling continues while the status isn't available yet (ErrorCodeTestNotificationNotFound); other errors are returned.
+// @return The final status of the test notification. The error is non-nil when your server didn't accept the notification.
+func (c *AppStoreServerAPIClient) SendTestNotificationAndWait(ctx context.Context, pollInterval time.Duration) (*models.CheckTestNotificationResponse, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultTestNotificationPollInterval
+	}
+	sent, err := c.RequestTestNotification()
+	if err != nil {
+		return nil, err
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+		status, err := c.GetTestNotificationStatus(sent.TestNotificationToken)
+		if err != nil {
+			var apiErr APIError
+			// The App Store answers TestNotificationNotFound until the status is available.
+			if errors.As(err, &apiErr) && ErrorCodeTestNotificationNotFound == apiErr.ErrorCode {
+				continue
+			}
+			return nil, err
+		}
+		if 0 == len(status.SendAttempts) {
+			continue
+		}
+		last := status.SendAttempts[len(status.SendAttempts)-1]
+		if types.SendAttemptResultSuccess != last.SendAttemptResult {
+			return status, fmt.Errorf("test notification delivery failed: %s", last.SendAttemptResult)
+		}
+		return status, nil
+	}
+}
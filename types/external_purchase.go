@@ -0,0 +1,81 @@
+package types
+
+// ExternalPurchaseTokenType
+// The type of an external purchase token.
+type ExternalPurchaseTokenType = string
+
+const (
+	// ExternalPurchaseTokenTypeAcquisition
+	// A token for a customer’s first external purchase in the app.
+	ExternalPurchaseTokenTypeAcquisition ExternalPurchaseTokenType = "ACQUISITION"
+	// ExternalPurchaseTokenTypeServices
+	// A token for the external purchases a customer makes after the acquisition.
+	ExternalPurchaseTokenTypeServices ExternalPurchaseTokenType = "SERVICES"
+)
+
+// ExternalPurchaseEventType
+// The type of event a line item of an external purchase report records.
+type ExternalPurchaseEventType = string
+
+const (
+	// ExternalPurchaseEventTypePurchase
+	// The customer purchased the product.
+	ExternalPurchaseEventTypePurchase ExternalPurchaseEventType = "PURCHASE"
+	// ExternalPurchaseEventTypeRefund
+	// The customer received a refund for a purchase you reported earlier.
+	ExternalPurchaseEventTypeRefund ExternalPurchaseEventType = "REFUND"
+)
+
+// ExternalPurchaseProductType
+// The type of product a line item of an external purchase report records.
+type ExternalPurchaseProductType = string
+
+const (
+	// ExternalPurchaseProductTypeOneTimeBuy
+	// A product the customer buys once.
+	ExternalPurchaseProductTypeOneTimeBuy ExternalPurchaseProductType = "ONE_TIME_BUY"
+	// ExternalPurchaseProductTypeSubscription
+	// A subscription.
+	ExternalPurchaseProductTypeSubscription ExternalPurchaseProductType = "SUBSCRIPTION"
+)
+
+// ExternalPurchaseReportStatus
+// The processing status of an external purchase report.
+type ExternalPurchaseReportStatus = string
+
+const (
+	// ExternalPurchaseReportStatusPending
+	// Apple hasn’t processed the report yet.
+	ExternalPurchaseReportStatusPending ExternalPurchaseReportStatus = "PENDING"
+	// ExternalPurchaseReportStatusAccepted
+	// Apple accepted the report.
+	ExternalPurchaseReportStatusAccepted ExternalPurchaseReportStatus = "ACCEPTED"
+	// ExternalPurchaseReportStatusRejected
+	// Apple rejected the report; its errors describe why.
+	ExternalPurchaseReportStatusRejected ExternalPurchaseReportStatus = "REJECTED"
+)
+
+// ExternalPurchaseErrorCode
+// An error code the External Purchase Server API returns, in the errorCode of an error response or of a rejected report.
+type ExternalPurchaseErrorCode = int64
+
+const (
+	// ExternalPurchaseErrorCodeGeneralBadRequest
+	// The request is invalid.
+	ExternalPurchaseErrorCodeGeneralBadRequest ExternalPurchaseErrorCode = 4000000
+	// ExternalPurchaseErrorCodeInvalidAppIdentifier
+	// The app identifier is invalid.
+	ExternalPurchaseErrorCodeInvalidAppIdentifier ExternalPurchaseErrorCode = 4000002
+	// ExternalPurchaseErrorCodeInvalidRequestIdentifier
+	// The request identifier is invalid.
+	ExternalPurchaseErrorCodeInvalidRequestIdentifier ExternalPurchaseErrorCode = 4000011
+	// ExternalPurchaseErrorCodeRateLimitExceeded
+	// The request exceeded the rate limit.
+	ExternalPurchaseErrorCodeRateLimitExceeded ExternalPurchaseErrorCode = 4290000
+	// ExternalPurchaseErrorCodeGeneralInternal
+	// A general internal error.
+	ExternalPurchaseErrorCodeGeneralInternal ExternalPurchaseErrorCode = 5000000
+	// ExternalPurchaseErrorCodeGeneralInternalRetryable
+	// An unknown error occurred, but you can try again.
+	ExternalPurchaseErrorCodeGeneralInternalRetryable ExternalPurchaseErrorCode = 5000001
+)
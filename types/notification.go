@@ -0,0 +1,244 @@
+package types
+
+// NotificationTypeV2
+// The type that describes the in-app purchase or external purchase event for which the App Store sends the version 2 notification.
+type NotificationTypeV2 = string
+
+const (
+	// NotificationTypeV2Subscribed
+	// A notification type that, along with its subtype, indicates that the customer subscribed to an auto-renewable subscription.
+	NotificationTypeV2Subscribed NotificationTypeV2 = "SUBSCRIBED"
+	// NotificationTypeV2DidChangeRenewalPref
+	// A notification type that, along with its subtype, indicates that the customer made a change to their subscription plan.
+	NotificationTypeV2DidChangeRenewalPref NotificationTypeV2 = "DID_CHANGE_RENEWAL_PREF"
+	// NotificationTypeV2DidChangeRenewalStatus
+	// A notification type that, along with its subtype, indicates that the customer made a change to the subscription renewal status.
+	NotificationTypeV2DidChangeRenewalStatus NotificationTypeV2 = "DID_CHANGE_RENEWAL_STATUS"
+	// NotificationTypeV2OfferRedeemed
+	// A notification type that, along with its subtype, indicates that the customer redeemed a promotional offer or offer code.
+	NotificationTypeV2OfferRedeemed NotificationTypeV2 = "OFFER_REDEEMED"
+	// NotificationTypeV2DidRenew
+	// A notification type that, along with its subtype, indicates that the subscription successfully renewed.
+	NotificationTypeV2DidRenew NotificationTypeV2 = "DID_RENEW"
+	// NotificationTypeV2Expired
+	// A notification type that, along with its subtype, indicates that a subscription expired.
+	NotificationTypeV2Expired NotificationTypeV2 = "EXPIRED"
+	// NotificationTypeV2DidFailToRenew
+	// A notification type that, along with its subtype, indicates that the subscription failed to renew due to a billing issue.
+	NotificationTypeV2DidFailToRenew NotificationTypeV2 = "DID_FAIL_TO_RENEW"
+	// NotificationTypeV2GracePeriodExpired
+	// A notification type that indicates that the billing grace period has ended without renewing the subscription, so you can turn off access to the service or content.
+	NotificationTypeV2GracePeriodExpired NotificationTypeV2 = "GRACE_PERIOD_EXPIRED"
+	// NotificationTypeV2PriceIncrease
+	// A notification type that, along with its subtype, indicates that the system has informed the customer of an auto-renewable subscription price increase.
+	NotificationTypeV2PriceIncrease NotificationTypeV2 = "PRICE_INCREASE"
+	// NotificationTypeV2Refund
+	// A notification type that indicates that the App Store successfully refunded a transaction.
+	NotificationTypeV2Refund NotificationTypeV2 = "REFUND"
+	// NotificationTypeV2RefundDeclined
+	// A notification type that indicates the App Store declined a refund request.
+	NotificationTypeV2RefundDeclined NotificationTypeV2 = "REFUND_DECLINED"
+	// NotificationTypeV2ConsumptionRequest
+	// A notification type that indicates that the customer initiated a refund request for a consumable in-app purchase or auto-renewable subscription, and the App Store is requesting that you provide consumption data.
+	NotificationTypeV2ConsumptionRequest NotificationTypeV2 = "CONSUMPTION_REQUEST"
+	// NotificationTypeV2RenewalExtended
+	// A notification type that indicates the App Store extended the subscription renewal date for a specific subscription.
+	NotificationTypeV2RenewalExtended NotificationTypeV2 = "RENEWAL_EXTENDED"
+	// NotificationTypeV2Revoke
+	// A notification type that indicates that an in-app purchase the customer was entitled to through Family Sharing is no longer available through sharing.
+	NotificationTypeV2Revoke NotificationTypeV2 = "REVOKE"
+	// NotificationTypeV2Test
+	// A notification type that the App Store server sends when you request it by calling the Request a Test Notification endpoint.
+	NotificationTypeV2Test NotificationTypeV2 = "TEST"
+	// NotificationTypeV2RenewalExtension
+	// A notification type that, along with its subtype, indicates that the App Store is attempting to extend the subscription renewal date that you request by calling Extend Subscription Renewal Dates for All Active Subscribers.
+	NotificationTypeV2RenewalExtension NotificationTypeV2 = "RENEWAL_EXTENSION"
+	// NotificationTypeV2RefundReversed
+	// A notification type that indicates the App Store reversed a previously granted refund due to a dispute that the customer raised.
+	NotificationTypeV2RefundReversed NotificationTypeV2 = "REFUND_REVERSED"
+	// NotificationTypeV2ExternalPurchaseToken
+	// A notification type that, along with its subtype UNREPORTED, indicates that Apple created an external purchase token for your app, but didn’t receive a report.
+	NotificationTypeV2ExternalPurchaseToken NotificationTypeV2 = "EXTERNAL_PURCHASE_TOKEN"
+	// NotificationTypeV2OneTimeCharge
+	// A notification type that indicates the customer purchased a consumable, non-consumable, or non-renewing subscription.
+	NotificationTypeV2OneTimeCharge NotificationTypeV2 = "ONE_TIME_CHARGE"
+	// NotificationTypeV2MetadataUpdate
+	// A notification type that indicates the metadata of an Advanced Commerce subscription changed.
+	NotificationTypeV2MetadataUpdate NotificationTypeV2 = "METADATA_UPDATE"
+	// NotificationTypeV2Migration
+	// A notification type that indicates a subscription migrated to an Advanced Commerce subscription.
+	NotificationTypeV2Migration NotificationTypeV2 = "MIGRATION"
+	// NotificationTypeV2PriceChange
+	// A notification type that indicates the price of an Advanced Commerce subscription changed.
+	NotificationTypeV2PriceChange NotificationTypeV2 = "PRICE_CHANGE"
+)
+
+// Subtype
+// A string that provides details about select notification types in version 2.
+type Subtype = string
+
+const (
+	// SubtypeInitialBuy
+	// Applies to the SUBSCRIBED notificationType. The customer purchased the subscription for the first time or received access to it through Family Sharing.
+	SubtypeInitialBuy Subtype = "INITIAL_BUY"
+	// SubtypeResubscribe
+	// Applies to the SUBSCRIBED notificationType. The customer resubscribed to the same subscription or to another subscription within the same subscription group.
+	SubtypeResubscribe Subtype = "RESUBSCRIBE"
+	// SubtypeDowngrade
+	// Applies to the DID_CHANGE_RENEWAL_PREF notificationType. The customer downgraded a subscription or cross-graded to a subscription with a different duration.
+	SubtypeDowngrade Subtype = "DOWNGRADE"
+	// SubtypeUpgrade
+	// Applies to the DID_CHANGE_RENEWAL_PREF notificationType. The customer upgraded a subscription or cross-graded to a subscription with the same duration.
+	SubtypeUpgrade Subtype = "UPGRADE"
+	// SubtypeAutoRenewEnabled
+	// Applies to the DID_CHANGE_RENEWAL_STATUS notificationType. The customer reenabled subscription auto-renewal.
+	SubtypeAutoRenewEnabled Subtype = "AUTO_RENEW_ENABLED"
+	// SubtypeAutoRenewDisabled
+	// Applies to the DID_CHANGE_RENEWAL_STATUS notificationType. The customer disabled subscription auto-renewal, or the App Store disabled it after the customer requested a refund.
+	SubtypeAutoRenewDisabled Subtype = "AUTO_RENEW_DISABLED"
+	// SubtypeVoluntary
+	// Applies to the EXPIRED notificationType. The subscription expired after the customer turned off subscription auto-renewal.
+	SubtypeVoluntary Subtype = "VOLUNTARY"
+	// SubtypeBillingRetry
+	// Applies to the EXPIRED notificationType. The subscription expired because the billing retry period ended without a successful billing transaction.
+	SubtypeBillingRetry Subtype = "BILLING_RETRY"
+	// SubtypePriceIncrease
+	// Applies to the EXPIRED notificationType. The subscription expired because the customer didn’t consent to a price increase that requires customer consent.
+	SubtypePriceIncrease Subtype = "PRICE_INCREASE"
+	// SubtypeGracePeriod
+	// Applies to the DID_FAIL_TO_RENEW notificationType. The subscription failed to renew due to a billing issue and the subscription is in a billing grace period.
+	SubtypeGracePeriod Subtype = "GRACE_PERIOD"
+	// SubtypePending
+	// Applies to the PRICE_INCREASE notificationType. The customer hasn’t yet responded to a price increase that requires their consent.
+	SubtypePending Subtype = "PENDING"
+	// SubtypeAccepted
+	// Applies to the PRICE_INCREASE notificationType. The customer consented to a price increase, or the system notified them of a price increase that doesn’t require consent.
+	SubtypeAccepted Subtype = "ACCEPTED"
+	// SubtypeBillingRecovery
+	// Applies to the DID_RENEW notificationType. The expired subscription that previously failed to renew has successfully renewed.
+	SubtypeBillingRecovery Subtype = "BILLING_RECOVERY"
+	// SubtypeProductNotForSale
+	// Applies to the EXPIRED notificationType. The subscription expired because the product wasn’t available for purchase at the time the subscription attempted to renew.
+	SubtypeProductNotForSale Subtype = "PRODUCT_NOT_FOR_SALE"
+	// SubtypeSummary
+	// Applies to the RENEWAL_EXTENSION notificationType. The App Store server completed your request to extend the subscription renewal date for all eligible subscribers.
+	SubtypeSummary Subtype = "SUMMARY"
+	// SubtypeFailure
+	// Applies to the RENEWAL_EXTENSION notificationType. The subscription-renewal-date extension failed for an individual subscription.
+	SubtypeFailure Subtype = "FAILURE"
+	// SubtypeUnreported
+	// Applies to the EXTERNAL_PURCHASE_TOKEN notificationType. Apple created a token for your app but didn’t receive a report.
+	SubtypeUnreported Subtype = "UNREPORTED"
+	// SubtypeActiveTokenReminder
+	// Applies to the EXTERNAL_PURCHASE_TOKEN notificationType. A reminder that a services token is still active and needs a report for the current period.
+	SubtypeActiveTokenReminder Subtype = "ACTIVE_TOKEN_REMINDER"
+)
+
+// SendAttemptResult
+// The success or error information the App Store server records when it attempts to send an App Store server notification to your server.
+type SendAttemptResult = string
+
+const (
+	// SendAttemptResultSuccess
+	// The App Store server received a success response when it sent the notification to your server.
+	SendAttemptResultSuccess SendAttemptResult = "SUCCESS"
+	// SendAttemptResultTimedOut
+	// The App Store server didn’t receive a response from your server within the timeout period.
+	SendAttemptResultTimedOut SendAttemptResult = "TIMED_OUT"
+	// SendAttemptResultTlsIssue
+	// The App Store server couldn’t establish a TLS session or failed a TLS handshake with your server.
+	SendAttemptResultTlsIssue SendAttemptResult = "TLS_ISSUE"
+	// SendAttemptResultCircularRedirect
+	// The App Store server detected a continual redirect.
+	SendAttemptResultCircularRedirect SendAttemptResult = "CIRCULAR_REDIRECT"
+	// SendAttemptResultNoResponse
+	// The App Store server received no response from your server.
+	SendAttemptResultNoResponse SendAttemptResult = "NO_RESPONSE"
+	// SendAttemptResultSocketIssue
+	// The App Store server couldn’t open a network socket to your server.
+	SendAttemptResultSocketIssue SendAttemptResult = "SOCKET_ISSUE"
+	// SendAttemptResultUnsupportedCharset
+	// The App Store server doesn’t support the character set in your server’s response.
+	SendAttemptResultUnsupportedCharset SendAttemptResult = "UNSUPPORTED_CHARSET"
+	// SendAttemptResultInvalidResponse
+	// The App Store server received an invalid response from your server.
+	SendAttemptResultInvalidResponse SendAttemptResult = "INVALID_RESPONSE"
+	// SendAttemptResultPrematureClose
+	// The App Store server terminated the connection because your server closed it prematurely.
+	SendAttemptResultPrematureClose SendAttemptResult = "PREMATURE_CLOSE"
+	// SendAttemptResultUnsuccessfulHttpResponseCode
+	// The App Store server received an HTTP response status code other than 200 from your server.
+	SendAttemptResultUnsuccessfulHttpResponseCode SendAttemptResult = "UNSUCCESSFUL_HTTP_RESPONSE_CODE"
+	// SendAttemptResultOther
+	// Another error that isn’t in the list of send attempt results.
+	SendAttemptResultOther SendAttemptResult = "OTHER"
+)
+
+// ConsumptionRequestReason
+// The customer-provided reason for a refund request.
+type ConsumptionRequestReason = string
+
+const (
+	// ConsumptionRequestReasonUnintendedPurchase
+	// The customer didn’t intend to make the in-app purchase.
+	ConsumptionRequestReasonUnintendedPurchase ConsumptionRequestReason = "UNINTENDED_PURCHASE"
+	// ConsumptionRequestReasonFulfillmentIssue
+	// The customer had issues with receiving or using the in-app purchase.
+	ConsumptionRequestReasonFulfillmentIssue ConsumptionRequestReason = "FULFILLMENT_ISSUE"
+	// ConsumptionRequestReasonUnsatisfiedWithPurchase
+	// The customer wasn’t satisfied with the in-app purchase.
+	ConsumptionRequestReasonUnsatisfiedWithPurchase ConsumptionRequestReason = "UNSATISFIED_WITH_PURCHASE"
+	// ConsumptionRequestReasonLegal
+	// The customer requested a refund based on a legal reason.
+	ConsumptionRequestReasonLegal ConsumptionRequestReason = "LEGAL"
+	// ConsumptionRequestReasonOther
+	// The customer requested a refund for other reasons.
+	ConsumptionRequestReasonOther ConsumptionRequestReason = "OTHER"
+)
+
+// NotificationTypeV1
+// The type of a version 1 App Store Server Notification.
+// @see <a href="https://developer.apple.com/documentation/appstoreservernotifications/notification_type">notification_type</a>
+type NotificationTypeV1 = string
+
+const (
+	// NotificationTypeV1Cancel
+	// Apple customer support canceled the auto-renewable subscription and the customer received a refund.
+	NotificationTypeV1Cancel NotificationTypeV1 = "CANCEL"
+	// NotificationTypeV1ConsumptionRequest
+	// The customer initiated a refund request for a consumable in-app purchase.
+	NotificationTypeV1ConsumptionRequest NotificationTypeV1 = "CONSUMPTION_REQUEST"
+	// NotificationTypeV1DidChangeRenewalPref
+	// The customer made a change in their subscription plan that takes effect at the next renewal.
+	NotificationTypeV1DidChangeRenewalPref NotificationTypeV1 = "DID_CHANGE_RENEWAL_PREF"
+	// NotificationTypeV1DidChangeRenewalStatus
+	// The subscription renewal status changed; auto_renew_status holds the new status.
+	NotificationTypeV1DidChangeRenewalStatus NotificationTypeV1 = "DID_CHANGE_RENEWAL_STATUS"
+	// NotificationTypeV1DidFailToRenew
+	// The subscription failed to renew due to a billing issue.
+	NotificationTypeV1DidFailToRenew NotificationTypeV1 = "DID_FAIL_TO_RENEW"
+	// NotificationTypeV1DidRecover
+	// The App Store successfully recovered an expired subscription that failed to renew due to a billing issue.
+	NotificationTypeV1DidRecover NotificationTypeV1 = "DID_RECOVER"
+	// NotificationTypeV1DidRenew
+	// The customer’s subscription successfully auto-renewed for a new transaction period.
+	NotificationTypeV1DidRenew NotificationTypeV1 = "DID_RENEW"
+	// NotificationTypeV1InitialBuy
+	// The customer completed an initial purchase of a subscription.
+	NotificationTypeV1InitialBuy NotificationTypeV1 = "INITIAL_BUY"
+	// NotificationTypeV1InteractiveRenewal
+	// The customer renewed a subscription interactively after it lapsed.
+	NotificationTypeV1InteractiveRenewal NotificationTypeV1 = "INTERACTIVE_RENEWAL"
+	// NotificationTypeV1PriceIncreaseConsent
+	// The App Store started asking the customer to consent to a subscription price increase.
+	NotificationTypeV1PriceIncreaseConsent NotificationTypeV1 = "PRICE_INCREASE_CONSENT"
+	// NotificationTypeV1Refund
+	// The App Store successfully refunded a transaction.
+	NotificationTypeV1Refund NotificationTypeV1 = "REFUND"
+	// NotificationTypeV1Revoke
+	// An in-app purchase shared through Family Sharing is no longer available through sharing.
+	NotificationTypeV1Revoke NotificationTypeV1 = "REVOKE"
+	// NotificationTypeV1Renewal
+	// Deprecated: replaced by DID_RECOVER. The App Store recovered an expired subscription.
+	NotificationTypeV1Renewal NotificationTypeV1 = "RENEWAL"
+)
@@ -138,3 +138,423 @@ const (
 	AdvancedCommercePriceIncreaseInfoStatusPending   AdvancedCommercePriceIncreaseInfoStatus = "PENDING"
 	AdvancedCommercePriceIncreaseInfoStatusAccepted  AdvancedCommercePriceIncreaseInfoStatus = "ACCEPTED"
 )
+
+// InAppOwnershipType
+// The relationship of the user with the family-shared purchase to which they have access.
+type InAppOwnershipType = string
+
+const (
+	// InAppOwnershipTypeFamilyShared
+	// The transaction belongs to a family member who benefits from service.
+	InAppOwnershipTypeFamilyShared InAppOwnershipType = "FAMILY_SHARED"
+	// InAppOwnershipTypePurchased
+	// The transaction belongs to the purchaser.
+	InAppOwnershipTypePurchased InAppOwnershipType = "PURCHASED"
+)
+
+// ProductType
+// The type of In-App Purchase product used to filter the transaction history.
+type ProductType = string
+
+const (
+	// ProductTypeAutoRenewable
+	// An auto-renewable subscription.
+	ProductTypeAutoRenewable ProductType = "AUTO_RENEWABLE"
+	// ProductTypeNonRenewable
+	// A non-renewing subscription.
+	ProductTypeNonRenewable ProductType = "NON_RENEWABLE"
+	// ProductTypeConsumable
+	// A consumable In-App Purchase.
+	ProductTypeConsumable ProductType = "CONSUMABLE"
+	// ProductTypeNonConsumable
+	// A non-consumable In-App Purchase.
+	ProductTypeNonConsumable ProductType = "NON_CONSUMABLE"
+)
+
+// Order
+// The order of the transactions in the transaction history, based on the modified date.
+type Order = string
+
+const (
+	// OrderAscending
+	// Sort the transactions in ascending order.
+	OrderAscending Order = "ASCENDING"
+	// OrderDescending
+	// Sort the transactions in descending order.
+	OrderDescending Order = "DESCENDING"
+)
+
+// OrderLookupStatus
+// A value that indicates whether the order ID in the request is valid for your app.
+type OrderLookupStatus = int32
+
+const (
+	// OrderLookupStatusValid
+	// The order ID is valid.
+	OrderLookupStatusValid OrderLookupStatus = iota
+	// OrderLookupStatusInvalid
+	// The order ID is invalid.
+	OrderLookupStatusInvalid
+)
+
+// ConsumptionStatus
+// A value that indicates the extent to which the customer consumed the in-app purchase.
+type ConsumptionStatus = int32
+
+const (
+	// ConsumptionStatusUndeclared
+	// The consumption status is undeclared.
+	ConsumptionStatusUndeclared ConsumptionStatus = iota
+	// ConsumptionStatusNotConsumed
+	// The in-app purchase is not consumed.
+	ConsumptionStatusNotConsumed
+	// ConsumptionStatusPartiallyConsumed
+	// The in-app purchase is partially consumed.
+	ConsumptionStatusPartiallyConsumed
+	// ConsumptionStatusFullyConsumed
+	// The in-app purchase is fully consumed.
+	ConsumptionStatusFullyConsumed
+)
+
+// Platform
+// The platform on which the customer consumed the in-app purchase.
+type Platform = int32
+
+const (
+	// PlatformUndeclared
+	// Undeclared.
+	PlatformUndeclared Platform = iota
+	// PlatformApple
+	// An Apple platform.
+	PlatformApple
+	// PlatformNonApple
+	// Non-Apple platform.
+	PlatformNonApple
+)
+
+// DeliveryStatus
+// A value that indicates whether the app successfully delivered an in-app purchase that works properly.
+type DeliveryStatus = int32
+
+const (
+	// DeliveryStatusDeliveredAndWorkingProperly
+	// The app delivered the consumable in-app purchase and it’s working properly.
+	DeliveryStatusDeliveredAndWorkingProperly DeliveryStatus = iota
+	// DeliveryStatusDidNotDeliverDueToQualityIssue
+	// The app didn’t deliver the consumable in-app purchase due to a quality issue.
+	DeliveryStatusDidNotDeliverDueToQualityIssue
+	// DeliveryStatusDeliveredWrongItem
+	// The app delivered the wrong item.
+	DeliveryStatusDeliveredWrongItem
+	// DeliveryStatusDidNotDeliverDueToServerOutage
+	// The app didn’t deliver the consumable in-app purchase due to a server outage.
+	DeliveryStatusDidNotDeliverDueToServerOutage
+	// DeliveryStatusDidNotDeliverDueToInGameCurrencyChange
+	// The app didn’t deliver the consumable in-app purchase due to an in-game currency change.
+	DeliveryStatusDidNotDeliverDueToInGameCurrencyChange
+	// DeliveryStatusDidNotDeliverForOtherReason
+	// The app didn’t deliver the consumable in-app purchase for other reasons.
+	DeliveryStatusDidNotDeliverForOtherReason
+)
+
+// AccountTenure
+// The age of the customer’s account.
+type AccountTenure = int32
+
+const (
+	// AccountTenureUndeclared
+	// Account age is undeclared.
+	AccountTenureUndeclared AccountTenure = iota
+	// AccountTenureZeroToThreeDays
+	// Account age is between 0–3 days.
+	AccountTenureZeroToThreeDays
+	// AccountTenureThreeDaysToTenDays
+	// Account age is between 3–10 days.
+	AccountTenureThreeDaysToTenDays
+	// AccountTenureTenDaysToThirtyDays
+	// Account age is between 10–30 days.
+	AccountTenureTenDaysToThirtyDays
+	// AccountTenureThirtyDaysToNinetyDays
+	// Account age is between 30–90 days.
+	AccountTenureThirtyDaysToNinetyDays
+	// AccountTenureNinetyDaysToOneHundredEightyDays
+	// Account age is between 90–180 days.
+	AccountTenureNinetyDaysToOneHundredEightyDays
+	// AccountTenureOneHundredEightyDaysToThreeHundredSixtyFiveDays
+	// Account age is between 180–365 days.
+	AccountTenureOneHundredEightyDaysToThreeHundredSixtyFiveDays
+	// AccountTenureGreaterThanThreeHundredSixtyFiveDays
+	// Account age is over 365 days.
+	AccountTenureGreaterThanThreeHundredSixtyFiveDays
+)
+
+// PlayTime
+// A value that indicates the amount of time that the customer used the app.
+type PlayTime = int32
+
+const (
+	// PlayTimeUndeclared
+	// The engagement time is undeclared.
+	PlayTimeUndeclared PlayTime = iota
+	// PlayTimeZeroToFiveMinutes
+	// The engagement time is between 0–5 minutes.
+	PlayTimeZeroToFiveMinutes
+	// PlayTimeFiveToSixtyMinutes
+	// The engagement time is between 5–60 minutes.
+	PlayTimeFiveToSixtyMinutes
+	// PlayTimeOneToSixHours
+	// The engagement time is between 1–6 hours.
+	PlayTimeOneToSixHours
+	// PlayTimeSixHoursToTwentyFourHours
+	// The engagement time is between 6–24 hours.
+	PlayTimeSixHoursToTwentyFourHours
+	// PlayTimeOneDayToFourDays
+	// The engagement time is between 1–4 days.
+	PlayTimeOneDayToFourDays
+	// PlayTimeFourDaysToSixteenDays
+	// The engagement time is between 4–16 days.
+	PlayTimeFourDaysToSixteenDays
+	// PlayTimeOverSixteenDays
+	// The engagement time is over 16 days.
+	PlayTimeOverSixteenDays
+)
+
+// LifetimeDollarsPurchased
+// A value that indicates the total amount, in USD, of in-app purchases the customer has made in your app, across all platforms.
+type LifetimeDollarsPurchased = int32
+
+const (
+	// LifetimeDollarsPurchasedUndeclared
+	// Lifetime purchase amount is undeclared.
+	LifetimeDollarsPurchasedUndeclared LifetimeDollarsPurchased = iota
+	// LifetimeDollarsPurchasedZeroDollars
+	// Lifetime purchase amount is 0 USD.
+	LifetimeDollarsPurchasedZeroDollars
+	// LifetimeDollarsPurchasedOneCentToFortyNineDollarsAndNinetyNineCents
+	// Lifetime purchase amount is between 0.01–49.99 USD.
+	LifetimeDollarsPurchasedOneCentToFortyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsPurchasedFiftyDollarsToNinetyNineDollarsAndNinetyNineCents
+	// Lifetime purchase amount is between 50–99.99 USD.
+	LifetimeDollarsPurchasedFiftyDollarsToNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsPurchasedOneHundredDollarsToFourHundredNinetyNineDollarsAndNinetyNineCents
+	// Lifetime purchase amount is between 100–499.99 USD.
+	LifetimeDollarsPurchasedOneHundredDollarsToFourHundredNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsPurchasedFiveHundredDollarsToNineHundredNinetyNineDollarsAndNinetyNineCents
+	// Lifetime purchase amount is between 500–999.99 USD.
+	LifetimeDollarsPurchasedFiveHundredDollarsToNineHundredNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsPurchasedOneThousandDollarsToOneThousandNineHundredNinetyNineDollarsAndNinetyNineCents
+	// Lifetime purchase amount is between 1000–1999.99 USD.
+	LifetimeDollarsPurchasedOneThousandDollarsToOneThousandNineHundredNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsPurchasedTwoThousandDollarsOrGreater
+	// Lifetime purchase amount is over 2000 USD.
+	LifetimeDollarsPurchasedTwoThousandDollarsOrGreater
+)
+
+// LifetimeDollarsRefunded
+// A value that indicates the dollar amount of refunds the customer has received in your app, since purchasing the app, across all platforms.
+type LifetimeDollarsRefunded = int32
+
+const (
+	// LifetimeDollarsRefundedUndeclared
+	// Lifetime refund amount is undeclared.
+	LifetimeDollarsRefundedUndeclared LifetimeDollarsRefunded = iota
+	// LifetimeDollarsRefundedZeroDollars
+	// Lifetime refund amount is 0 USD.
+	LifetimeDollarsRefundedZeroDollars
+	// LifetimeDollarsRefundedOneCentToFortyNineDollarsAndNinetyNineCents
+	// Lifetime refund amount is between 0.01–49.99 USD.
+	LifetimeDollarsRefundedOneCentToFortyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsRefundedFiftyDollarsToNinetyNineDollarsAndNinetyNineCents
+	// Lifetime refund amount is between 50–99.99 USD.
+	LifetimeDollarsRefundedFiftyDollarsToNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsRefundedOneHundredDollarsToFourHundredNinetyNineDollarsAndNinetyNineCents
+	// Lifetime refund amount is between 100–499.99 USD.
+	LifetimeDollarsRefundedOneHundredDollarsToFourHundredNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsRefundedFiveHundredDollarsToNineHundredNinetyNineDollarsAndNinetyNineCents
+	// Lifetime refund amount is between 500–999.99 USD.
+	LifetimeDollarsRefundedFiveHundredDollarsToNineHundredNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsRefundedOneThousandDollarsToOneThousandNineHundredNinetyNineDollarsAndNinetyNineCents
+	// Lifetime refund amount is between 1000–1999.99 USD.
+	LifetimeDollarsRefundedOneThousandDollarsToOneThousandNineHundredNinetyNineDollarsAndNinetyNineCents
+	// LifetimeDollarsRefundedTwoThousandDollarsOrGreater
+	// Lifetime refund amount is over 2000 USD.
+	LifetimeDollarsRefundedTwoThousandDollarsOrGreater
+)
+
+// UserStatus
+// The status of a customer’s account within your app.
+type UserStatus = int32
+
+const (
+	// UserStatusUndeclared
+	// Account status is undeclared.
+	UserStatusUndeclared UserStatus = iota
+	// UserStatusActive
+	// The customer’s account is active.
+	UserStatusActive
+	// UserStatusSuspended
+	// The customer’s account is suspended.
+	UserStatusSuspended
+	// UserStatusTerminated
+	// The customer’s account is terminated.
+	UserStatusTerminated
+	// UserStatusLimitedAccess
+	// The customer’s account has limited access.
+	UserStatusLimitedAccess
+)
+
+// RefundPreference
+// A value that indicates your preferred outcome for the refund request.
+type RefundPreference = int32
+
+const (
+	// RefundPreferenceUndeclared
+	// The refund preference is undeclared. Use this value to avoid providing information for this field.
+	RefundPreferenceUndeclared RefundPreference = iota
+	// RefundPreferencePreferGrant
+	// You prefer that Apple grants the refund.
+	RefundPreferencePreferGrant
+	// RefundPreferencePreferDecline
+	// You prefer that Apple declines the refund.
+	RefundPreferencePreferDecline
+	// RefundPreferenceNoPreference
+	// You have no preference whether Apple grants or declines the refund.
+	RefundPreferenceNoPreference
+)
+
+// ExtendReasonCode
+// The code that represents the reason for the subscription-renewal-date extension.
+type ExtendReasonCode = int32
+
+const (
+	// ExtendReasonCodeUndeclared
+	// Undeclared; no information provided.
+	ExtendReasonCodeUndeclared ExtendReasonCode = iota
+	// ExtendReasonCodeCustomerSatisfaction
+	// The renewal-date extension is for customer satisfaction.
+	ExtendReasonCodeCustomerSatisfaction
+	// ExtendReasonCodeOther
+	// The renewal-date extension is for other reasons.
+	ExtendReasonCodeOther
+	// ExtendReasonCodeServiceIssueOrOutage
+	// The renewal-date extension is due to a service issue or outage.
+	ExtendReasonCodeServiceIssueOrOutage
+)
+
+// ImageState
+// The approval state of an image.
+type ImageState = string
+
+const (
+	// ImageStatePending
+	// The image is awaiting approval.
+	ImageStatePending ImageState = "PENDING"
+	// ImageStateApproved
+	// The image is approved.
+	ImageStateApproved ImageState = "APPROVED"
+	// ImageStateRejected
+	// The image is rejected.
+	ImageStateRejected ImageState = "REJECTED"
+)
+
+// MessageState
+// The approval state of the message.
+type MessageState = string
+
+const (
+	// MessageStatePending
+	// The message is awaiting approval.
+	MessageStatePending MessageState = "PENDING"
+	// MessageStateApproved
+	// The message is approved.
+	MessageStateApproved MessageState = "APPROVED"
+	// MessageStateRejected
+	// The message is rejected.
+	MessageStateRejected MessageState = "REJECTED"
+)
+
+// AdvancedCommercePeriod
+// The duration of a single cycle of an Advanced Commerce auto-renewable subscription, in ISO 8601 duration format.
+type AdvancedCommercePeriod = string
+
+const (
+	AdvancedCommercePeriodOneWeek     AdvancedCommercePeriod = "P1W"
+	AdvancedCommercePeriodOneMonth    AdvancedCommercePeriod = "P1M"
+	AdvancedCommercePeriodTwoMonths   AdvancedCommercePeriod = "P2M"
+	AdvancedCommercePeriodThreeMonths AdvancedCommercePeriod = "P3M"
+	AdvancedCommercePeriodSixMonths   AdvancedCommercePeriod = "P6M"
+	AdvancedCommercePeriodOneYear     AdvancedCommercePeriod = "P1Y"
+)
+
+// AdvancedCommerceEffective
+// A value that indicates when a change to an Advanced Commerce subscription takes effect.
+type AdvancedCommerceEffective = string
+
+const (
+	// AdvancedCommerceEffectiveImmediately
+	// The change takes effect immediately.
+	AdvancedCommerceEffectiveImmediately AdvancedCommerceEffective = "IMMEDIATELY"
+	// AdvancedCommerceEffectiveNextBillCycle
+	// The change takes effect at the next billing cycle.
+	AdvancedCommerceEffectiveNextBillCycle AdvancedCommerceEffective = "NEXT_BILL_CYCLE"
+)
+
+// AdvancedCommerceRefundReason
+// The reason for a refund of an Advanced Commerce item.
+type AdvancedCommerceRefundReason = string
+
+const (
+	// AdvancedCommerceRefundReasonUnintendedPurchase
+	// The customer didn't intend to make the purchase.
+	AdvancedCommerceRefundReasonUnintendedPurchase AdvancedCommerceRefundReason = "UNINTENDED_PURCHASE"
+	// AdvancedCommerceRefundReasonFulfillmentIssue
+	// The customer didn't receive the purchased content, or it didn't work.
+	AdvancedCommerceRefundReasonFulfillmentIssue AdvancedCommerceRefundReason = "FULFILLMENT_ISSUE"
+	// AdvancedCommerceRefundReasonUnsatisfiedWithPurchase
+	// The customer is unsatisfied with the purchase.
+	AdvancedCommerceRefundReasonUnsatisfiedWithPurchase AdvancedCommerceRefundReason = "UNSATISFIED_WITH_PURCHASE"
+	// AdvancedCommerceRefundReasonLegal
+	// The refund is for legal reasons.
+	AdvancedCommerceRefundReasonLegal AdvancedCommerceRefundReason = "LEGAL"
+	// AdvancedCommerceRefundReasonOther
+	// The refund is for another reason.
+	AdvancedCommerceRefundReasonOther AdvancedCommerceRefundReason = "OTHER"
+	// AdvancedCommerceRefundReasonModifyItemsRefund
+	// The refund results from a modification of the items of a subscription.
+	AdvancedCommerceRefundReasonModifyItemsRefund AdvancedCommerceRefundReason = "MODIFY_ITEMS_REFUND"
+	// AdvancedCommerceRefundReasonSimulateRefundDecline
+	// A sandbox-only reason that simulates a declined refund.
+	AdvancedCommerceRefundReasonSimulateRefundDecline AdvancedCommerceRefundReason = "SIMULATE_REFUND_DECLINE"
+)
+
+// AdvancedCommerceRefundType
+// The type of a refund of an Advanced Commerce item.
+type AdvancedCommerceRefundType = string
+
+const (
+	// AdvancedCommerceRefundTypeFull
+	// A refund of the full price.
+	AdvancedCommerceRefundTypeFull AdvancedCommerceRefundType = "FULL"
+	// AdvancedCommerceRefundTypeProrated
+	// A refund prorated over the unused part of the billing period.
+	AdvancedCommerceRefundTypeProrated AdvancedCommerceRefundType = "PRORATED"
+	// AdvancedCommerceRefundTypeCustom
+	// A refund of an amount you provide.
+	AdvancedCommerceRefundTypeCustom AdvancedCommerceRefundType = "CUSTOM"
+)
+
+// AdvancedCommerceOperation
+// The operation of an Advanced Commerce in-app request.
+type AdvancedCommerceOperation = string
+
+const (
+	// AdvancedCommerceOperationCreateSubscription
+	// Create an Advanced Commerce subscription.
+	AdvancedCommerceOperationCreateSubscription AdvancedCommerceOperation = "CREATE_SUBSCRIPTION"
+	// AdvancedCommerceOperationModifySubscription
+	// Add, change or remove items, descriptors or the period of an Advanced Commerce subscription.
+	AdvancedCommerceOperationModifySubscription AdvancedCommerceOperation = "MODIFY_SUBSCRIPTION"
+	// AdvancedCommerceOperationCreateOneTimeCharge
+	// Charge the customer once for an Advanced Commerce item.
+	AdvancedCommerceOperationCreateOneTimeCharge AdvancedCommerceOperation = "CREATE_ONE_TIME_CHARGE"
+)
@@ -0,0 +1,50 @@
+package types
+
+// VerifyReceiptStatus
+// The status of the app receipt returned by the verifyReceipt endpoint.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/status">status</a>
+type VerifyReceiptStatus = int32
+
+const (
+	// VerifyReceiptStatusValid
+	// The receipt is valid.
+	VerifyReceiptStatusValid VerifyReceiptStatus = 0
+	// VerifyReceiptStatusBadRequest
+	// The request to the App Store didn’t use the HTTP POST request method.
+	VerifyReceiptStatusBadRequest VerifyReceiptStatus = 21000
+	// VerifyReceiptStatusNotAvailable
+	// The App Store no longer sends this status code.
+	VerifyReceiptStatusNotAvailable VerifyReceiptStatus = 21001
+	// VerifyReceiptStatusMalformedReceipt
+	// The data in the receipt-data property is malformed or the service experienced a temporary issue. Try again.
+	VerifyReceiptStatusMalformedReceipt VerifyReceiptStatus = 21002
+	// VerifyReceiptStatusUnauthenticated
+	// The system couldn’t authenticate the receipt.
+	VerifyReceiptStatusUnauthenticated VerifyReceiptStatus = 21003
+	// VerifyReceiptStatusSharedSecretMismatch
+	// The shared secret you provided doesn’t match the shared secret on file for your account.
+	VerifyReceiptStatusSharedSecretMismatch VerifyReceiptStatus = 21004
+	// VerifyReceiptStatusServerUnavailable
+	// The receipt server was temporarily unable to provide the receipt. Try again.
+	VerifyReceiptStatusServerUnavailable VerifyReceiptStatus = 21005
+	// VerifyReceiptStatusSubscriptionExpired
+	// This receipt is valid, but the subscription is in an expired state. Only returned for iOS 6-style transaction receipts.
+	VerifyReceiptStatusSubscriptionExpired VerifyReceiptStatus = 21006
+	// VerifyReceiptStatusSandboxReceipt
+	// This receipt is from the test environment, but you sent it to the production environment for verification.
+	VerifyReceiptStatusSandboxReceipt VerifyReceiptStatus = 21007
+	// VerifyReceiptStatusProductionReceipt
+	// This receipt is from the production environment, but you sent it to the test environment for verification.
+	VerifyReceiptStatusProductionReceipt VerifyReceiptStatus = 21008
+	// VerifyReceiptStatusInternalDataAccessError
+	// Internal data access error. Try again later.
+	VerifyReceiptStatusInternalDataAccessError VerifyReceiptStatus = 21009
+	// VerifyReceiptStatusAccountNotFound
+	// The system can’t find the user account or the user account has been deleted.
+	VerifyReceiptStatusAccountNotFound VerifyReceiptStatus = 21010
+)
+
+// IsVerifyReceiptInternalError reports whether status is in the 21100-21199 range of internal data access errors.
+func IsVerifyReceiptInternalError(status VerifyReceiptStatus) bool {
+	return status >= 21100 && status <= 21199
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/meetleev/go-apple-store-server/models"
 	"github.com/meetleev/go-apple-store-server/types"
 )
 
@@ -221,6 +222,73 @@ func (p *SignedDataVerifier) DecodeAndVerifySignedPayload(signedData string, pay
 	return nil
 }
 
+// VerifyAndDecodeAppTransaction verifies and decodes a signedAppTransactionInfo, such as the one returned by
+// the Get App Transaction Info endpoint. In the Production environment the appAppleId is checked as well.
+func (p *SignedDataVerifier) VerifyAndDecodeAppTransaction(signedAppTransaction string) (*models.AppTransactionDecodedPayload, error) {
+	payload := &models.AppTransactionDecodedPayload{}
+	if err := p.DecodeAndVerifySignedPayload(signedAppTransaction, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// VerifyAndDecodeNotification verifies and decodes the signedPayload of an App Store Server Notification.
+// The bundleId, environment and appAppleId of the data, summary or external purchase token are checked the same way as those of a transaction.
+// The signedTransactionInfo and signedRenewalInfo of the data are verified and decoded too, into Data.TransactionInfo and Data.RenewalInfo;
+// their bundleId and environment must match those of the notification.
+func (p *SignedDataVerifier) VerifyAndDecodeNotification(signedPayload string) (*models.ResponseBodyV2DecodedPayload, error) {
+	payload := &models.ResponseBodyV2DecodedPayload{}
+	if err := p.DecodeAndVerifySignedPayload(signedPayload, payload); err != nil {
+		return nil, err
+	}
+	if nil != payload.ExternalPurchaseToken {
+		if err := p.validateClaims(payload.ExternalPurchaseToken); err != nil {
+			return nil, err
+		}
+	}
+	if nil != payload.Summary {
+		if err := p.validateClaims(payload.Summary); err != nil {
+			return nil, err
+		}
+	}
+	if nil != payload.Data {
+		if err := p.verifyNotificationData(payload.Data); err != nil {
+			return nil, err
+		}
+	}
+	return payload, nil
+}
+
+func (p *SignedDataVerifier) verifyNotificationData(data *models.NotificationData) error {
+	if err := p.validateClaims(data); err != nil {
+		return err
+	}
+	if "" != data.SignedTransactionInfo {
+		transaction := &models.JWSTransactionDecodedPayload{}
+		if err := p.DecodeAndVerifySignedPayload(data.SignedTransactionInfo, transaction); err != nil {
+			return err
+		}
+		if transaction.BundleId != data.BundleId {
+			return fmt.Errorf("transaction bundle id mismatch: got %q want %q", transaction.BundleId, data.BundleId)
+		}
+		if transaction.Environment != data.Environment {
+			return fmt.Errorf("transaction environment mismatch: got %q want %q", transaction.Environment, data.Environment)
+		}
+		data.TransactionInfo = transaction
+	}
+	if "" != data.SignedRenewalInfo {
+		renewal := &models.JWSRenewalInfoDecodedPayload{}
+		if err := p.DecodeAndVerifySignedPayload(data.SignedRenewalInfo, renewal); err != nil {
+			return err
+		}
+		if renewal.Environment != data.Environment {
+			return fmt.Errorf("renewal info environment mismatch: got %q want %q", renewal.Environment, data.Environment)
+		}
+		data.RenewalInfo = renewal
+	}
+	return nil
+}
+
 func (p *SignedDataVerifier) DecodeSignedPayload(signedData string, payload interface{}) error {
 	_, err := p.Parse(signedData, payload)
 	if err != nil {
@@ -237,6 +305,10 @@ type environmentProvider interface {
 	EnvironmentValue() string
 }
 
+type appAppleIDProvider interface {
+	AppAppleID() int64
+}
+
 func (p *SignedDataVerifier) validateClaims(payload interface{}) error {
 	if p.bundleId != "" {
 		if bundleProvider, ok := payload.(bundleIDProvider); ok {
@@ -254,6 +326,14 @@ func (p *SignedDataVerifier) validateClaims(payload interface{}) error {
 		}
 	}
 
+	if p.environment == types.EnvProduction && p.appAppleId != nil {
+		if appleIdProvider, ok := payload.(appAppleIDProvider); ok {
+			if appleIdProvider.AppAppleID() != *p.appAppleId {
+				return fmt.Errorf("app apple id mismatch: got %d want %d", appleIdProvider.AppAppleID(), *p.appAppleId)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,118 @@
+package apple_store_server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	VerifyReceiptProductionUrl = "https://buy.itunes.apple.com/verifyReceipt"
+	VerifyReceiptSandboxUrl    = "https://sandbox.itunes.apple.com/verifyReceipt"
+)
+
+// VerifyReceiptError is returned with the response when the verifyReceipt endpoint answers with a non-zero status.
+type VerifyReceiptError struct {
+	Status      types.VerifyReceiptStatus `json:"status"`
+	IsRetryable bool                      `json:"is-retryable"`
+}
+
+func (e VerifyReceiptError) Error() string {
+	return fmt.Sprintf("{status:%d, isRetryable:%t}", e.Status, e.IsRetryable)
+}
+
+// VerifyReceiptClient
+// A client for the deprecated verifyReceipt endpoint, for apps that still send StoreKit 1 receipts.
+// Prefer ReceiptUtility with AppStoreServerAPIClient, or the receipt package, for new code.
+// @see <a href="https://developer.apple.com/documentation/appstorereceipts/verifyreceipt">verifyReceipt</a>
+type VerifyReceiptClient struct {
+	// Your app’s shared secret from App Store Connect
+	sharedSecret string
+	urlBase      string
+	// The endpoints of each environment, VerifyReceiptProductionUrl and VerifyReceiptSandboxUrl outside tests.
+	productionUrl string
+	sandboxUrl    string
+}
+
+// NewVerifyReceiptClient creates a client that sends receipts to production first.
+func NewVerifyReceiptClient(sharedSecret string) *VerifyReceiptClient {
+	p := &VerifyReceiptClient{sharedSecret: sharedSecret, productionUrl: VerifyReceiptProductionUrl, sandboxUrl: VerifyReceiptSandboxUrl}
+	p.SetEnv(types.EnvProduction)
+	return p
+}
+
+// SetEnv sets the environment receipts are sent to first.
+func (c *VerifyReceiptClient) SetEnv(environment types.Environment) {
+	if types.EnvProduction == environment {
+		c.urlBase = c.productionUrl
+	} else {
+		c.urlBase = c.sandboxUrl
+	}
+}
+
+// VerifyReceipt
+// Send a receipt to the App Store for verification. A sandbox receipt sent to production (21007) is sent again
+// to sandbox, and a production receipt sent to sandbox (21008) is sent again to production.
+// @param receiptData The Base64-encoded receipt data.
+// @param excludeOldTransactions Whether latest_receipt_info contains only the latest renewal transaction of each subscription.
+// @return The response of the App Store. A non-zero status is returned as VerifyReceiptError along with the response.
+// @throws APIException If a response was returned indicating the request could not be processed
+func (c *VerifyReceiptClient) VerifyReceipt(receiptData string, excludeOldTransactions bool) (*models.VerifyReceiptResponse, error) {
+	request := &models.VerifyReceiptRequest{ReceiptData: receiptData, Password: c.sharedSecret, ExcludeOldTransactions: excludeOldTransactions}
+	response, err := c.makeRequest(c.urlBase, request)
+	if nil != err {
+		return nil, err
+	}
+	switch {
+	case types.VerifyReceiptStatusSandboxReceipt == response.Status && c.productionUrl == c.urlBase:
+		response, err = c.makeRequest(c.sandboxUrl, request)
+	case types.VerifyReceiptStatusProductionReceipt == response.Status && c.sandboxUrl == c.urlBase:
+		response, err = c.makeRequest(c.productionUrl, request)
+	}
+	if nil != err {
+		return nil, err
+	}
+	if types.VerifyReceiptStatusValid != response.Status {
+		return response, VerifyReceiptError{Status: response.Status, IsRetryable: response.IsRetryable}
+	}
+	return response, nil
+}
+
+func (c *VerifyReceiptClient) makeRequest(url string, request *models.VerifyReceiptRequest) (*models.VerifyReceiptResponse, error) {
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		logger.Errorf("json marshal error: %v", err)
+		return nil, err
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.Errorf("Error making request: %v", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err = io.ReadAll(resp.Body)
+	if err != nil {
+		logger.Errorf("Error reading response body: %v", err)
+		return nil, err
+	}
+	if http.StatusOK != resp.StatusCode {
+		return nil, APIError{HttpStatusCode: resp.StatusCode}
+	}
+	response := &models.VerifyReceiptResponse{}
+	if err = json.Unmarshal(body, response); err != nil {
+		logger.Errorf("parse VerifyReceipt response body failed [%v]", err.Error())
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,90 @@
+package apple_store_server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/meetleev/go-apple-store-server/models"
+	"github.com/meetleev/go-apple-store-server/types"
+)
+
+// newTestVerifyReceiptServer answers every request with status and records the requests it receives.
+func newTestVerifyReceiptServer(t *testing.T, environment types.Environment, status types.VerifyReceiptStatus, requests *[]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &models.VerifyReceiptRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil || "receipt" != request.ReceiptData || "secret" != request.Password || !request.ExcludeOldTransactions {
+			t.Errorf("unexpected request %+v [%v]", request, err)
+		}
+		*requests = append(*requests, string(environment))
+		_ = json.NewEncoder(w).Encode(&models.VerifyReceiptResponse{Environment: environment, Status: status})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVerifyReceipt(t *testing.T) {
+	tests := []struct {
+		name             string
+		env              types.Environment
+		productionStatus types.VerifyReceiptStatus
+		sandboxStatus    types.VerifyReceiptStatus
+		requests         []string
+		environment      types.Environment
+		status           types.VerifyReceiptStatus
+	}{
+		{"production receipt", types.EnvProduction, types.VerifyReceiptStatusValid, types.VerifyReceiptStatusValid,
+			[]string{"Production"}, types.EnvProduction, types.VerifyReceiptStatusValid},
+		{"sandbox receipt sent to production", types.EnvProduction, types.VerifyReceiptStatusSandboxReceipt, types.VerifyReceiptStatusValid,
+			[]string{"Production", "Sandbox"}, types.EnvSandbox, types.VerifyReceiptStatusValid},
+		{"production receipt sent to sandbox", types.EnvSandbox, types.VerifyReceiptStatusValid, types.VerifyReceiptStatusProductionReceipt,
+			[]string{"Sandbox", "Production"}, types.EnvProduction, types.VerifyReceiptStatusValid},
+		{"production status from sandbox is not retried", types.EnvProduction, types.VerifyReceiptStatusSandboxReceipt, types.VerifyReceiptStatusProductionReceipt,
+			[]string{"Production", "Sandbox"}, types.EnvSandbox, types.VerifyReceiptStatusProductionReceipt},
+		{"invalid receipt", types.EnvProduction, types.VerifyReceiptStatusMalformedReceipt, types.VerifyReceiptStatusValid,
+			[]string{"Production"}, types.EnvProduction, types.VerifyReceiptStatusMalformedReceipt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			client := NewVerifyReceiptClient("secret")
+			client.productionUrl = newTestVerifyReceiptServer(t, types.EnvProduction, tt.productionStatus, &requests).URL
+			client.sandboxUrl = newTestVerifyReceiptServer(t, types.EnvSandbox, tt.sandboxStatus, &requests).URL
+			client.SetEnv(tt.env)
+
+			response, err := client.VerifyReceipt("receipt", true)
+			var verifyErr VerifyReceiptError
+			if types.VerifyReceiptStatusValid == tt.status && nil != err {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if types.VerifyReceiptStatusValid != tt.status && (!errors.As(err, &verifyErr) || tt.status != verifyErr.Status) {
+				t.Fatalf("got error %v, want status %d", err, tt.status)
+			}
+			if nil == response || tt.environment != response.Environment || tt.status != response.Status {
+				t.Errorf("unexpected response %+v", response)
+			}
+			if !reflect.DeepEqual(tt.requests, requests) {
+				t.Errorf("got requests %v, want %v", requests, tt.requests)
+			}
+		})
+	}
+}
+
+func TestVerifyReceiptHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	client := NewVerifyReceiptClient("secret")
+	client.productionUrl = server.URL
+	client.SetEnv(types.EnvProduction)
+
+	var apiErr APIError
+	if _, err := client.VerifyReceipt("receipt", true); !errors.As(err, &apiErr) || http.StatusServiceUnavailable != apiErr.HttpStatusCode {
+		t.Errorf("got error %v, want an APIError with status 503", err)
+	}
+}